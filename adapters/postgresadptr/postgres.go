@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
 	"github.com/RuanScherer/journey-track-api/config"
 	"github.com/RuanScherer/journey-track-api/domain/model"
 	"gorm.io/driver/postgres"
@@ -41,10 +42,78 @@ func connect() *gorm.DB {
 		panic("failed to connect database: " + err.Error())
 	}
 
-	err = db.AutoMigrate(&model.User{}, &model.Project{}, &model.ProjectInvite{}, &model.Event{})
+	err = db.AutoMigrate(&model.User{}, &model.Project{}, &model.ProjectInvite{}, &model.Event{}, &model.EndUserIdentity{}, &model.EndUserSession{}, &model.ProjectApiKey{}, &model.IngestionSignature{}, &model.ProjectUsage{}, &repository.RateLimitBucket{}, &model.ProjectMember{}, &model.ProjectOwnershipTransfer{}, &model.ProjectInviteLink{}, &model.ProjectInviteLinkJoin{}, &model.UserSession{}, &model.UserRefreshToken{}, &model.PersonalAccessToken{})
 	if err != nil {
 		panic("failed to migrate database: " + err.Error())
 	}
 
+	// members added before roles existed get the column default, so owners are promoted here
+	err = db.Exec(
+		"update user_projects set role = ? from projects "+
+			"where projects.id = user_projects.project_id and projects.owner_id = user_projects.user_id and user_projects.role <> ?",
+		model.ProjectRoleOwner,
+		model.ProjectRoleOwner,
+	).Error
+	if err != nil {
+		panic("failed to migrate project owners roles: " + err.Error())
+	}
+
+	// invites created before expiry existed expire as if they had been created with it
+	err = db.Exec(
+		"update project_invites set expires_at = created_at + ? * interval '1 second' where expires_at is null",
+		int64(model.ProjectInviteTTL.Seconds()),
+	).Error
+	if err != nil {
+		panic("failed to migrate project invites expiry: " + err.Error())
+	}
+
+	// invites sent before email invites existed always belong to a user, whose email is copied to the invite
+	err = db.Exec(
+		"update project_invites set email = lower(users.email) from users " +
+			"where users.id = project_invites.user_id and project_invites.email = ''",
+	).Error
+	if err != nil {
+		panic("failed to migrate project invites emails: " + err.Error())
+	}
+
+	// users are looked up by their email case insensitively
+	err = db.Exec("create index if not exists idx_users_email_lower on users (lower(email))").Error
+	if err != nil {
+		panic("failed to index users emails: " + err.Error())
+	}
+
+	err = migrateProjectTokens(db)
+	if err != nil {
+		panic("failed to migrate project tokens: " + err.Error())
+	}
+
 	return db
 }
+
+// migrateProjectTokens turns the tokens projects had before api keys existed into ingest keys,
+// so deployed SDKs keep sending events, and only then drops the token column
+func migrateProjectTokens(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&model.Project{}, "token") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(
+			"insert into project_api_keys "+
+				"(id, created_at, updated_at, project_id, name, scope, prefix, key_hash, created_by_id, allow_unsigned) "+
+				"select gen_random_uuid()::text, now(), now(), projects.id, ?, ?, left(projects.token, ?), "+
+				"encode(sha256(convert_to(projects.token, 'UTF8')), 'hex'), projects.owner_id, false "+
+				"from projects where projects.token is not null and not exists ("+
+				"select 1 from project_api_keys "+
+				"where project_api_keys.key_hash = encode(sha256(convert_to(projects.token, 'UTF8')), 'hex'))",
+			model.ProjectApiKeyLegacyName,
+			model.ProjectApiKeyScopeIngest,
+			model.ProjectApiKeyDisplayChars,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropColumn(&model.Project{}, "token")
+	})
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EndUserIdentityPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewEndUserIdentityPostgresRepository(db *gorm.DB) *EndUserIdentityPostgresRepository {
+	return &EndUserIdentityPostgresRepository{DB: db}
+}
+
+// an anonymous ID can only be identified once, so registering it again keeps the first identity
+func (repository *EndUserIdentityPostgresRepository) Register(identity *model.EndUserIdentity) error {
+	return repository.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "anonymous_id"}},
+			DoNothing: true,
+		}).
+		Create(identity).Error
+}
+
+func (repository *EndUserIdentityPostgresRepository) FindByAnonymousID(
+	projectID string,
+	anonymousID string,
+) (*model.EndUserIdentity, error) {
+	identity := &model.EndUserIdentity{}
+	err := repository.DB.
+		Where("project_id = ? and anonymous_id = ?", projectID, anonymousID).
+		First(identity).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/scope"
+	domainrepositories "github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type EndUserSessionPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewEndUserSessionPostgresRepository(db *gorm.DB) *EndUserSessionPostgresRepository {
+	return &EndUserSessionPostgresRepository{DB: db}
+}
+
+func (repository *EndUserSessionPostgresRepository) BatchSave(sessions []*model.EndUserSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	return repository.DB.Omit("Project").Save(sessions).Error
+}
+
+func (repository *EndUserSessionPostgresRepository) ListByClientSessionIDs(
+	projectID string,
+	clientSessionIDs []string,
+) ([]*model.EndUserSession, error) {
+	sessions := []*model.EndUserSession{}
+	if len(clientSessionIDs) == 0 {
+		return sessions, nil
+	}
+
+	err := repository.DB.
+		Where("project_id = ? and client_session_id in (?)", projectID, clientSessionIDs).
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// ListByEndUserIDs returns the sessions without a client session ID that overlap the given time range
+func (repository *EndUserSessionPostgresRepository) ListByEndUserIDs(
+	projectID string,
+	endUserIDs []string,
+	from time.Time,
+	to time.Time,
+) ([]*model.EndUserSession, error) {
+	sessions := []*model.EndUserSession{}
+	if len(endUserIDs) == 0 {
+		return sessions, nil
+	}
+
+	err := repository.DB.
+		Where("project_id = ? and end_user_id in (?) and client_session_id is null", projectID, endUserIDs).
+		Where("started_at <= ? and ended_at >= ?", to, from).
+		Order("started_at").
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (repository *EndUserSessionPostgresRepository) List(
+	options domainrepositories.EndUserSessionListOptions,
+) ([]*model.EndUserSession, error) {
+	sessions := []*model.EndUserSession{}
+	err := repository.filter(options).
+		Order("started_at desc").
+		Scopes(
+			scope.Paginate(scope.PaginationOptions{
+				Page:     options.Page,
+				PageSize: options.PageSize,
+			}),
+		).
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (repository *EndUserSessionPostgresRepository) Aggregate(
+	options domainrepositories.EndUserSessionListOptions,
+) (*domainrepositories.EndUserSessionAggregate, error) {
+	aggregate := &domainrepositories.EndUserSessionAggregate{}
+	err := repository.filter(options).
+		Select(
+			"count(*) as sessions_count, " +
+				"count(*) filter (where event_count = 1) as bounced_sessions_count, " +
+				"coalesce(avg(duration_seconds), 0) as average_duration_seconds, " +
+				"coalesce(avg(event_count), 0) as average_event_count",
+		).
+		Scan(aggregate).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return aggregate, nil
+}
+
+func (repository *EndUserSessionPostgresRepository) filter(
+	options domainrepositories.EndUserSessionListOptions,
+) *gorm.DB {
+	query := repository.DB.
+		Model(&model.EndUserSession{}).
+		Where("project_id = ? and started_at >= ? and started_at < ?", options.ProjectID, options.From, options.To)
+	if options.EndUserID != "" {
+		query = query.Where("end_user_id = ?", options.EndUserID)
+	}
+	return query
+}
+
+// RunLocked takes transaction scoped advisory locks, in the same order for every request so they can't deadlock
+func (repository *EndUserSessionPostgresRepository) RunLocked(
+	projectID string,
+	sessionKeys []string,
+	track func(domainrepositories.EndUserSessionRepository) error,
+) error {
+	lockIDs := make([]int64, 0, len(sessionKeys))
+	for _, sessionKey := range sessionKeys {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte("end_user_session:" + projectID + ":" + sessionKey))
+		lockIDs = append(lockIDs, int64(hash.Sum64()))
+	}
+	slices.Sort(lockIDs)
+	lockIDs = slices.Compact(lockIDs)
+
+	return repository.DB.Transaction(func(tx *gorm.DB) error {
+		for _, lockID := range lockIDs {
+			err := tx.Exec("select pg_advisory_xact_lock(?)", lockID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return track(NewEndUserSessionPostgresRepository(tx))
+	})
+}
@@ -1,8 +1,13 @@
 package repository
 
 import (
+	"fmt"
+	"time"
+
+	domainrepositories "github.com/RuanScherer/journey-track-api/application/repository"
 	"github.com/RuanScherer/journey-track-api/domain/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventPostgresRepository struct {
@@ -13,6 +18,216 @@ func NewEventPostgresRepository(db *gorm.DB) *EventPostgresRepository {
 	return &EventPostgresRepository{DB: db}
 }
 
-func (repository *EventPostgresRepository) Register(event *model.Event) error {
-	return repository.DB.Create(event).Error
+func (repository *EventPostgresRepository) Register(event *model.Event) (bool, error) {
+	result := repository.DB.Clauses(skipDuplicatedClientEventIDs()).Create(event)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repository *EventPostgresRepository) BatchRegister(events []*model.Event) ([]*model.Event, error) {
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	// gorm matches returned rows to the events by position, which breaks once some of them are skipped,
+	// so only the ID is returned and the registered events are looked up afterwards
+	err := repository.DB.
+		Clauses(skipDuplicatedClientEventIDs(), clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Create(events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// skipped events weren't stored under their own ID, which tells them apart from the registered ones
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+	registeredEventIDs := []string{}
+	err = repository.DB.
+		Model(&model.Event{}).
+		Where("id in (?)", eventIDs).
+		Pluck("id", &registeredEventIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	isRegistered := make(map[string]bool, len(registeredEventIDs))
+	for _, eventID := range registeredEventIDs {
+		isRegistered[eventID] = true
+	}
+	registeredEvents := make([]*model.Event, 0, len(registeredEventIDs))
+	for _, event := range events {
+		if isRegistered[event.ID] {
+			registeredEvents = append(registeredEvents, event)
+		}
+	}
+	return registeredEvents, nil
+}
+
+func (repository *EventPostgresRepository) ReleaseClientEventIDs(
+	projectID string,
+	clientEventIDs []string,
+	receivedBefore time.Time,
+) error {
+	if len(clientEventIDs) == 0 {
+		return nil
+	}
+	return repository.DB.
+		Model(&model.Event{}).
+		Where("project_id = ? and client_event_id in (?) and received_at < ?", projectID, clientEventIDs, receivedBefore).
+		Update("client_event_id", nil).Error
+}
+
+// events sent again with an already stored client event ID are retries, so they are silently ignored
+func skipDuplicatedClientEventIDs() clause.OnConflict {
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "client_event_id"}},
+		DoNothing: true,
+	}
+}
+
+func (repository *EventPostgresRepository) List(options domainrepositories.EventListOptions) ([]*model.Event, error) {
+	query := repository.DB.Where("project_id = ?", options.ProjectID)
+	if options.Name != "" {
+		query = query.Where("name = ?", options.Name)
+	}
+	if options.From != nil {
+		query = query.Where("timestamp >= ?", *options.From)
+	}
+	if options.To != nil {
+		query = query.Where("timestamp < ?", *options.To)
+	}
+	for key, value := range options.Properties {
+		query = query.Where("properties ->> ? = ?", key, value)
+	}
+
+	order, comparator := domainrepositories.EventListOrderDesc, "<"
+	if options.Order == domainrepositories.EventListOrderAsc {
+		order, comparator = domainrepositories.EventListOrderAsc, ">"
+	}
+	if options.After != nil {
+		query = query.Where(
+			fmt.Sprintf("(timestamp, id) %s (?, ?)", comparator),
+			options.After.Timestamp,
+			options.After.ID,
+		)
+	}
+
+	events := []*model.Event{}
+	err := query.
+		Order(fmt.Sprintf("timestamp %s, id %s", order, order)).
+		Limit(options.Limit).
+		Find(&events).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (repository *EventPostgresRepository) CountByInterval(
+	options domainrepositories.EventCountByIntervalOptions,
+) ([]*domainrepositories.EventIntervalCount, error) {
+	query := repository.DB.
+		Model(&model.Event{}).
+		Select(
+			"date_trunc(?, timestamp at time zone ?) as interval_start, count(*) as count",
+			options.Interval,
+			options.Timezone,
+		).
+		Where("project_id = ? and timestamp >= ? and timestamp < ?", options.ProjectID, options.From, options.To)
+	if options.Name != "" {
+		query = query.Where("name = ?", options.Name)
+	}
+
+	counts := []*domainrepositories.EventIntervalCount{}
+	err := query.
+		Group("interval_start").
+		Order("interval_start").
+		Scan(&counts).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// events are attributed to the distinct ID their anonymous ID was identified as, falling back to the IDs sent
+// with them. Clients that don't know the anonymous ID apart send it as the distinct ID before login.
+const eventActorIDExpression = "coalesce(distinct_identities.distinct_id, events.distinct_id, " +
+	"anonymous_identities.distinct_id, events.anonymous_id)"
+
+func (repository *EventPostgresRepository) ListOccurrences(
+	options domainrepositories.EventOccurrenceOptions,
+) ([]*domainrepositories.EventOccurrence, error) {
+	query := repository.actorEvents().
+		Select(fmt.Sprintf(
+			"events.id as event_id, %s as actor_id, events.name, events.timestamp, events.properties",
+			eventActorIDExpression,
+		)).
+		Where(
+			"events.project_id = ? and events.timestamp >= ? and events.timestamp < ?",
+			options.ProjectID,
+			options.From,
+			options.To,
+		).
+		Where(fmt.Sprintf("%s is not null", eventActorIDExpression))
+	if len(options.Names) > 0 {
+		query = query.Where("events.name in (?)", options.Names)
+	}
+	if options.After != nil {
+		query = query.Where(
+			fmt.Sprintf("(%s, events.timestamp, events.id) > (?, ?, ?)", eventActorIDExpression),
+			options.After.ActorID,
+			options.After.Timestamp,
+			options.After.EventID,
+		)
+	}
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+
+	occurrences := []*domainrepositories.EventOccurrence{}
+	err := query.
+		Order("actor_id, events.timestamp, events.id").
+		Scan(&occurrences).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+// ListFirstOccurrences looks at the whole event history, as an end user who performed the event before From
+// didn't do it for the first time in the range
+func (repository *EventPostgresRepository) ListFirstOccurrences(
+	options domainrepositories.EventFirstOccurrenceOptions,
+) ([]*domainrepositories.EventOccurrence, error) {
+	occurrences := []*domainrepositories.EventOccurrence{}
+	err := repository.actorEvents().
+		Select(fmt.Sprintf("%s as actor_id, events.name, min(events.timestamp) as timestamp", eventActorIDExpression)).
+		Where("events.project_id = ? and events.name = ? and events.timestamp < ?", options.ProjectID, options.Name, options.To).
+		Where(fmt.Sprintf("%s is not null", eventActorIDExpression)).
+		Group("actor_id, events.name").
+		Having("min(events.timestamp) >= ?", options.From).
+		Order("actor_id").
+		Scan(&occurrences).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+// actorEvents joins the identities events are attributed to, see eventActorIDExpression
+func (repository *EventPostgresRepository) actorEvents() *gorm.DB {
+	return repository.DB.
+		Model(&model.Event{}).
+		Joins("left join end_user_identities distinct_identities on distinct_identities.project_id = events.project_id " +
+			"and distinct_identities.anonymous_id = events.distinct_id and distinct_identities.deleted_at is null").
+		Joins("left join end_user_identities anonymous_identities on anonymous_identities.project_id = events.project_id " +
+			"and anonymous_identities.anonymous_id = events.anonymous_id and anonymous_identities.deleted_at is null")
 }
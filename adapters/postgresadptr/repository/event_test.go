@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestEventPostgresRepository_BatchRegister(t *testing.T) {
+	t.Run("should return only the events registered when the batch has retries", func(t *testing.T) {
+		owner, _ := model.NewUser("owner@example.com", "Owner", "pass1234")
+		_ = owner.Verify(*owner.VerificationToken)
+		project, _ := model.NewProject("Test", owner)
+
+		retriedEvent, _ := model.NewEvent("Retried", nil, project)
+		_ = retriedEvent.AssignClientEventID("client-event-1")
+		newEvent, _ := model.NewEvent("New", nil, project)
+		_ = newEvent.AssignClientEventID("client-event-2")
+
+		store := &fakeEventStore{
+			ids:            map[string]bool{},
+			clientEventIDs: map[string]bool{project.ID + "/client-event-1": true},
+		}
+		repository := NewEventPostgresRepository(openFakeEventStore(t, store))
+
+		registeredEvents, err := repository.BatchRegister([]*model.Event{retriedEvent, newEvent})
+		require.Nil(t, err)
+		require.Equal(t, []*model.Event{newEvent}, registeredEvents)
+		require.Equal(t, "client-event-1", *retriedEvent.ClientEventID)
+		require.Equal(t, "client-event-2", *newEvent.ClientEventID)
+	})
+}
+
+func openFakeEventStore(t *testing.T, store *fakeEventStore) *gorm.DB {
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(store)}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)},
+	)
+	require.Nil(t, err)
+	return db
+}
+
+// fakeEventStore stands for the events table, skipping the rows whose client event ID is already stored
+// like the insert does with the project and client event ID unique index
+type fakeEventStore struct {
+	ids            map[string]bool
+	clientEventIDs map[string]bool
+}
+
+func (store *fakeEventStore) Connect(context.Context) (driver.Conn, error) {
+	return &fakeEventStoreConn{store: store}, nil
+}
+
+func (store *fakeEventStore) Driver() driver.Driver {
+	return nil
+}
+
+type fakeEventStoreConn struct {
+	store *fakeEventStore
+}
+
+func (conn *fakeEventStoreConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (conn *fakeEventStoreConn) Close() error {
+	return nil
+}
+
+func (conn *fakeEventStoreConn) Begin() (driver.Tx, error) {
+	return conn, nil
+}
+
+func (conn *fakeEventStoreConn) Commit() error {
+	return nil
+}
+
+func (conn *fakeEventStoreConn) Rollback() error {
+	return nil
+}
+
+func (conn *fakeEventStoreConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (conn *fakeEventStoreConn) QueryContext(
+	_ context.Context,
+	query string,
+	args []driver.NamedValue,
+) (driver.Rows, error) {
+	switch {
+	case strings.HasPrefix(query, `INSERT INTO "events"`):
+		return conn.insertEvents(query, args), nil
+	case strings.HasPrefix(query, `SELECT "id" FROM "events"`):
+		rows := &fakeRows{columns: []string{"id"}}
+		for _, arg := range args {
+			if id, ok := arg.Value.(string); ok && conn.store.ids[id] {
+				rows.values = append(rows.values, []driver.Value{id})
+			}
+		}
+		return rows, nil
+	case strings.HasPrefix(query, "INSERT INTO"):
+		// the event associations are upserted along with them, but they don't matter here
+		return &fakeRows{}, nil
+	}
+	return nil, errors.New("unexpected query: " + query)
+}
+
+func (conn *fakeEventStoreConn) insertEvents(query string, args []driver.NamedValue) *fakeRows {
+	columnsList := query[strings.Index(query, "(")+1 : strings.Index(query, ")")]
+	columns := strings.Split(strings.ReplaceAll(columnsList, `"`, ""), ",")
+	columnIndex := map[string]int{}
+	for i, column := range columns {
+		columnIndex[column] = i
+	}
+
+	rows := &fakeRows{}
+	if returning := strings.Index(query, " RETURNING "); returning >= 0 {
+		rows.columns = strings.Split(strings.ReplaceAll(query[returning+len(" RETURNING "):], `"`, ""), ",")
+	}
+	for start := 0; start+len(columns) <= len(args); start += len(columns) {
+		values := args[start : start+len(columns)]
+		id, _ := values[columnIndex["id"]].Value.(string)
+		projectID, _ := values[columnIndex["project_id"]].Value.(string)
+		if clientEventID, ok := values[columnIndex["client_event_id"]].Value.(string); ok {
+			if conn.store.clientEventIDs[projectID+"/"+clientEventID] {
+				continue
+			}
+			conn.store.clientEventIDs[projectID+"/"+clientEventID] = true
+		}
+		conn.store.ids[id] = true
+
+		returnedValues := make([]driver.Value, 0, len(rows.columns))
+		for _, column := range rows.columns {
+			if i, ok := columnIndex[column]; ok {
+				returnedValues = append(returnedValues, values[i].Value)
+			} else {
+				returnedValues = append(returnedValues, nil)
+			}
+		}
+		rows.values = append(rows.values, returnedValues)
+	}
+	return rows
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (rows *fakeRows) Columns() []string {
+	return rows.columns
+}
+
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if len(rows.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, rows.values[0])
+	rows.values = rows.values[1:]
+	return nil
+}
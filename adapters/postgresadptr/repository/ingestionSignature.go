@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type IngestionSignaturePostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewIngestionSignaturePostgresRepository(db *gorm.DB) *IngestionSignaturePostgresRepository {
+	return &IngestionSignaturePostgresRepository{DB: db}
+}
+
+func (repository *IngestionSignaturePostgresRepository) Register(signature *model.IngestionSignature) error {
+	return repository.DB.Omit("Project").Create(signature).Error
+}
+
+// signatures are hard deleted, otherwise soft deleted rows would still hold the unique index
+func (repository *IngestionSignaturePostgresRepository) DeleteSignedBefore(projectID string, before time.Time) error {
+	return repository.DB.
+		Unscoped().
+		Where("project_id = ? and signed_at < ?", projectID, before).
+		Delete(&model.IngestionSignature{}).Error
+}
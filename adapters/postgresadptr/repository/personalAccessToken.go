@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PersonalAccessTokenPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewPersonalAccessTokenPostgresRepository(db *gorm.DB) *PersonalAccessTokenPostgresRepository {
+	return &PersonalAccessTokenPostgresRepository{DB: db}
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) Create(token *model.PersonalAccessToken) error {
+	return repository.DB.Omit(clause.Associations).Create(token).Error
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) Save(token *model.PersonalAccessToken) error {
+	return repository.DB.Omit(clause.Associations).Save(token).Error
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) FindById(tokenId string) (*model.PersonalAccessToken, error) {
+	token := &model.PersonalAccessToken{}
+	err := repository.DB.
+		Where("id = ?", tokenId).
+		First(token).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) FindByTokenHash(
+	tokenHash string,
+) (*model.PersonalAccessToken, error) {
+	token := &model.PersonalAccessToken{}
+	err := repository.DB.
+		Where("token_hash = ?", tokenHash).
+		First(token).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) ListActiveByUser(
+	userId string,
+	now time.Time,
+) ([]*model.PersonalAccessToken, error) {
+	tokens := []*model.PersonalAccessToken{}
+	err := repository.DB.
+		Where("user_id = ? and revoked_at is null and expires_at > ?", userId, now).
+		Order("created_at desc").
+		Find(&tokens).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) UpdateLastUsedAt(tokenId string, lastUsedAt time.Time) error {
+	return repository.DB.
+		Model(&model.PersonalAccessToken{}).
+		Where("id = ?", tokenId).
+		Update("last_used_at", lastUsedAt).Error
+}
+
+func (repository *PersonalAccessTokenPostgresRepository) RevokeAllByUser(userId string, now time.Time) error {
+	return repository.DB.
+		Model(&model.PersonalAccessToken{}).
+		Where("user_id = ? and revoked_at is null", userId).
+		Update("revoked_at", now).Error
+}
@@ -38,7 +38,7 @@ func (repository *ProjectPostgresRepository) FindByMemberId(memberId string) ([]
 func (repository *ProjectPostgresRepository) FindById(id string) (*model.Project, error) {
 	project := &model.Project{}
 	err := repository.DB.
-		Preload("Members").
+		Preload("Memberships.User").
 		Preload("Invites").
 		Where("id = ?", id).
 		First(project).Error
@@ -49,18 +49,6 @@ func (repository *ProjectPostgresRepository) FindById(id string) (*model.Project
 	return project, nil
 }
 
-func (repository *ProjectPostgresRepository) FindByToken(token string) (*model.Project, error) {
-	project := &model.Project{}
-	err := repository.DB.
-		Where("token = ?", token).
-		First(project).Error
-
-	if err != nil {
-		return nil, err
-	}
-	return project, nil
-}
-
 func (repository *ProjectPostgresRepository) FindMembersCountAndEventsCountById(
 	id string,
 ) (*domainrepositories.ProjectInvitesCountAndEventsCount, error) {
@@ -83,18 +71,3 @@ func (repository *ProjectPostgresRepository) DeleteById(id string) error {
 	err := repository.DB.Where("id = ?", id).Delete(&model.Project{}).Error
 	return err
 }
-
-func (repository *ProjectPostgresRepository) HasMember(projectID, memberID string) (bool, error) {
-	var count int64
-	err := repository.DB.
-		Table("user_projects").
-		Where("project_id = ?", projectID).
-		Where("user_id = ?", memberID).
-		Limit(1).
-		Count(&count).Error
-
-	if err != nil {
-		return false, err
-	}
-	return count > 0, nil
-}
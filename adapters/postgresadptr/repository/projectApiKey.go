@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type ProjectApiKeyPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewProjectApiKeyPostgresRepository(db *gorm.DB) *ProjectApiKeyPostgresRepository {
+	return &ProjectApiKeyPostgresRepository{DB: db}
+}
+
+func (repository *ProjectApiKeyPostgresRepository) Register(apiKey *model.ProjectApiKey) error {
+	return repository.DB.Omit("Project").Create(apiKey).Error
+}
+
+func (repository *ProjectApiKeyPostgresRepository) Save(apiKey *model.ProjectApiKey) error {
+	return repository.DB.Omit("Project").Save(apiKey).Error
+}
+
+func (repository *ProjectApiKeyPostgresRepository) FindById(id string) (*model.ProjectApiKey, error) {
+	apiKey := &model.ProjectApiKey{}
+	err := repository.DB.
+		Preload("Project").
+		Where("id = ?", id).
+		First(apiKey).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (repository *ProjectApiKeyPostgresRepository) FindByKeyHash(keyHash string) (*model.ProjectApiKey, error) {
+	apiKey := &model.ProjectApiKey{}
+	err := repository.DB.
+		Preload("Project").
+		Where("key_hash = ?", keyHash).
+		First(apiKey).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (repository *ProjectApiKeyPostgresRepository) FindByProjectId(projectID string) ([]*model.ProjectApiKey, error) {
+	apiKeys := []*model.ProjectApiKey{}
+	err := repository.DB.
+		Where("project_id = ?", projectID).
+		Order("created_at desc").
+		Find(&apiKeys).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+func (repository *ProjectApiKeyPostgresRepository) UpdateLastUsedAt(id string, lastUsedAt time.Time) error {
+	return repository.DB.
+		Model(&model.ProjectApiKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", lastUsedAt).Error
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/RuanScherer/journey-track-api/domain/model"
 	"gorm.io/gorm"
 )
@@ -33,11 +35,20 @@ func (repository *ProjectInvitePostgresRepository) DeleteById(projectInviteId st
 	return err
 }
 
+func (repository *ProjectInvitePostgresRepository) DeletePendingByProjectAndInviter(
+	projectId string,
+	inviterId string,
+) error {
+	return repository.DB.
+		Where("project_id = ? and invited_by_id = ? and status = ?", projectId, inviterId, model.ProjectInviteStatusPending).
+		Delete(&model.ProjectInvite{}).Error
+}
+
 func (repository *ProjectInvitePostgresRepository) FindById(projectInviteId string) (*model.ProjectInvite, error) {
 	projectInvite := &model.ProjectInvite{}
 	err := repository.DB.
 		Preload("User").
-		Preload("Project.Members").
+		Preload("Project.Memberships").
 		Where("id = ?", projectInviteId).
 		First(projectInvite).Error
 
@@ -73,6 +84,7 @@ func (repository *ProjectInvitePostgresRepository) FindPendingByUserAndProject(
 		Preload("User").
 		Preload("Project").
 		Where("user_id = ? and project_id = ? and status = ?", userId, projectId, model.ProjectInviteStatusPending).
+		Where("expires_at is null or expires_at > ?", time.Now()).
 		First(projectInvite).Error
 
 	if err != nil {
@@ -81,6 +93,37 @@ func (repository *ProjectInvitePostgresRepository) FindPendingByUserAndProject(
 	return projectInvite, nil
 }
 
+func (repository *ProjectInvitePostgresRepository) FindPendingByEmailAndProject(
+	email string,
+	projectId string,
+) (*model.ProjectInvite, error) {
+	projectInvite := &model.ProjectInvite{}
+	err := repository.DB.
+		Preload("User").
+		Preload("Project").
+		Where("email = ? and project_id = ? and status = ?", email, projectId, model.ProjectInviteStatusPending).
+		Where("expires_at is null or expires_at > ?", time.Now()).
+		First(projectInvite).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return projectInvite, nil
+}
+
+func (repository *ProjectInvitePostgresRepository) ListPendingUnattachedByEmail(email string) ([]*model.ProjectInvite, error) {
+	invites := []*model.ProjectInvite{}
+	err := repository.DB.
+		Where("email = ? and user_id is null and status = ?", email, model.ProjectInviteStatusPending).
+		Where("expires_at is null or expires_at > ?", time.Now()).
+		Find(&invites).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
 func (repository *ProjectInvitePostgresRepository) ListByProjectAndStatus(
 	projectId string,
 	status string,
@@ -97,3 +140,37 @@ func (repository *ProjectInvitePostgresRepository) ListByProjectAndStatus(
 	}
 	return invites, nil
 }
+
+func (repository *ProjectInvitePostgresRepository) ExpireOverdue(now time.Time) (int64, error) {
+	result := repository.DB.
+		Model(&model.ProjectInvite{}).
+		Where("status = ? and expires_at <= ?", model.ProjectInviteStatusPending, now).
+		Update("status", model.ProjectInviteStatusExpired)
+	return result.RowsAffected, result.Error
+}
+
+func (repository *ProjectInvitePostgresRepository) ListPendingToRemind(
+	now time.Time,
+	expiringBefore time.Time,
+) ([]*model.ProjectInvite, error) {
+	invites := []*model.ProjectInvite{}
+	err := repository.DB.
+		Preload("User").
+		Preload("Project").
+		Where("status = ? and reminder_sent_at is null", model.ProjectInviteStatusPending).
+		Where("expires_at > ? and expires_at <= ?", now, expiringBefore).
+		Find(&invites).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (repository *ProjectInvitePostgresRepository) ClaimReminder(projectInviteId string, now time.Time) (bool, error) {
+	result := repository.DB.
+		Model(&model.ProjectInvite{}).
+		Where("id = ? and reminder_sent_at is null", projectInviteId).
+		Update("reminder_sent_at", now)
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectInviteLinkPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewProjectInviteLinkPostgresRepository(db *gorm.DB) *ProjectInviteLinkPostgresRepository {
+	return &ProjectInviteLinkPostgresRepository{DB: db}
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) Create(link *model.ProjectInviteLink) error {
+	return repository.DB.Omit(clause.Associations).Create(link).Error
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) Save(link *model.ProjectInviteLink) error {
+	return repository.DB.Omit(clause.Associations).Save(link).Error
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) FindById(linkId string) (*model.ProjectInviteLink, error) {
+	link := &model.ProjectInviteLink{}
+	err := repository.DB.
+		Where("id = ?", linkId).
+		First(link).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) FindByToken(token string) (*model.ProjectInviteLink, error) {
+	link := &model.ProjectInviteLink{}
+	err := repository.DB.
+		Preload("Project.Memberships").
+		Where("token = ?", token).
+		First(link).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) ListByProject(projectId string) ([]*model.ProjectInviteLink, error) {
+	links := []*model.ProjectInviteLink{}
+	err := repository.DB.
+		Where("project_id = ?", projectId).
+		Order("created_at desc").
+		Find(&links).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func (repository *ProjectInviteLinkPostgresRepository) Join(
+	link *model.ProjectInviteLink,
+	join *model.ProjectInviteLinkJoin,
+) error {
+	return repository.DB.Transaction(func(tx *gorm.DB) error {
+		// concurrent joins may have used up the link since it was loaded
+		result := tx.
+			Model(&model.ProjectInviteLink{}).
+			Where("id = ? and revoked_at is null and expires_at > ?", link.ID, join.CreatedAt).
+			Where("max_uses is null or uses_count < max_uses").
+			Update("uses_count", gorm.Expr("uses_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		err := tx.Create(&model.ProjectMember{
+			ProjectID: join.ProjectID,
+			UserID:    join.UserID,
+			Role:      join.Role,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(join).Error
+	})
+}
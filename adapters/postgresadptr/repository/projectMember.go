@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type ProjectMemberPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewProjectMemberPostgresRepository(db *gorm.DB) *ProjectMemberPostgresRepository {
+	return &ProjectMemberPostgresRepository{DB: db}
+}
+
+func (repository *ProjectMemberPostgresRepository) FindByProjectAndUser(
+	projectID, userID string,
+) (*model.ProjectMember, error) {
+	member := &model.ProjectMember{}
+	err := repository.DB.
+		Where("project_id = ? and user_id = ?", projectID, userID).
+		First(member).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (repository *ProjectMemberPostgresRepository) Save(member *model.ProjectMember) error {
+	return repository.DB.Save(member).Error
+}
+
+func (repository *ProjectMemberPostgresRepository) Delete(member *model.ProjectMember) error {
+	return repository.DB.
+		Where("project_id = ? and user_id = ?", member.ProjectID, member.UserID).
+		Delete(&model.ProjectMember{}).Error
+}
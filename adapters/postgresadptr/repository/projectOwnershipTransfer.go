@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectOwnershipTransferPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewProjectOwnershipTransferPostgresRepository(db *gorm.DB) *ProjectOwnershipTransferPostgresRepository {
+	return &ProjectOwnershipTransferPostgresRepository{DB: db}
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) Create(transfer *model.ProjectOwnershipTransfer) error {
+	return repository.DB.Omit(clause.Associations).Create(transfer).Error
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) Save(transfer *model.ProjectOwnershipTransfer) error {
+	return repository.DB.Omit(clause.Associations).Save(transfer).Error
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) FindById(
+	transferId string,
+) (*model.ProjectOwnershipTransfer, error) {
+	transfer := &model.ProjectOwnershipTransfer{}
+	err := repository.DB.
+		Preload("Project").
+		Preload("FromUser").
+		Preload("ToUser").
+		Where("id = ?", transferId).
+		First(transfer).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) FindByProjectAndToken(
+	projectId string,
+	token string,
+) (*model.ProjectOwnershipTransfer, error) {
+	transfer := &model.ProjectOwnershipTransfer{}
+	err := repository.DB.
+		Where("project_id = ? and token = ?", projectId, token).
+		First(transfer).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) FindPendingByProject(
+	projectId string,
+) (*model.ProjectOwnershipTransfer, error) {
+	transfer := &model.ProjectOwnershipTransfer{}
+	err := repository.DB.
+		Where("project_id = ? and status = ?", projectId, model.ProjectOwnershipTransferStatusPending).
+		Order("created_at desc").
+		First(transfer).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) ListByProject(
+	projectId string,
+) ([]*model.ProjectOwnershipTransfer, error) {
+	transfers := []*model.ProjectOwnershipTransfer{}
+	err := repository.DB.
+		Preload("FromUser").
+		Preload("ToUser").
+		Where("project_id = ?", projectId).
+		Order("created_at desc").
+		Find(&transfers).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (repository *ProjectOwnershipTransferPostgresRepository) Confirm(
+	transfer *model.ProjectOwnershipTransfer,
+	project *model.Project,
+) error {
+	return repository.DB.Transaction(func(tx *gorm.DB) error {
+		// only updates the owner if it is still the one who requested the transfer
+		result := tx.
+			Model(&model.Project{}).
+			Where("id = ? and owner_id = ?", project.ID, transfer.FromUserID).
+			Update("owner_id", project.OwnerID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		for _, membership := range project.Memberships {
+			if membership.UserID != transfer.FromUserID && membership.UserID != transfer.ToUserID {
+				continue
+			}
+			err := tx.Save(membership).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Omit(clause.Associations).Save(transfer).Error
+	})
+}
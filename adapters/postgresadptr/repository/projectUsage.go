@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectUsagePostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewProjectUsagePostgresRepository(db *gorm.DB) *ProjectUsagePostgresRepository {
+	return &ProjectUsagePostgresRepository{DB: db}
+}
+
+func (repository *ProjectUsagePostgresRepository) IncrementEventCount(
+	projectID string,
+	periodStart time.Time,
+	count int,
+) error {
+	return repository.DB.
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "project_id"}, {Name: "period_start"}},
+			DoUpdates: clause.Set{{
+				Column: clause.Column{Name: "event_count"},
+				Value:  gorm.Expr("project_usages.event_count + excluded.event_count"),
+			}},
+		}).
+		Create(&model.ProjectUsage{ProjectID: projectID, PeriodStart: periodStart, EventCount: count}).Error
+}
+
+// ReserveEventCount increments the event count with a single conditional statement, so concurrent requests can't
+// reserve the same remaining quota
+func (repository *ProjectUsagePostgresRepository) ReserveEventCount(
+	projectID string,
+	periodStart time.Time,
+	count int,
+	quota int,
+) (bool, error) {
+	if count > quota {
+		return false, nil
+	}
+
+	result := repository.DB.
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "project_id"}, {Name: "period_start"}},
+			DoUpdates: clause.Set{{
+				Column: clause.Column{Name: "event_count"},
+				Value:  gorm.Expr("project_usages.event_count + excluded.event_count"),
+			}},
+			Where: clause.Where{Exprs: []clause.Expression{
+				gorm.Expr("project_usages.event_count + excluded.event_count <= ?", quota),
+			}},
+		}).
+		Create(&model.ProjectUsage{ProjectID: projectID, PeriodStart: periodStart, EventCount: count})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repository *ProjectUsagePostgresRepository) ReleaseEventCount(
+	projectID string,
+	periodStart time.Time,
+	count int,
+) error {
+	return repository.DB.
+		Model(&model.ProjectUsage{}).
+		Where("project_id = ? and period_start = ?", projectID, periodStart).
+		Update("event_count", gorm.Expr("greatest(0, event_count - ?)", count)).Error
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/ratelimit"
+	"gorm.io/gorm"
+)
+
+// RateLimitBucket persists token buckets so every instance shares the same rate limits
+type RateLimitBucket struct {
+	Key       string    `gorm:"primaryKey;type:varchar(255)"`
+	Tokens    float64   `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;autoUpdateTime:false"`
+	// RefilledAt is when the bucket is full again, from then on it's the same as a missing bucket and can be pruned
+	RefilledAt *time.Time `gorm:"type:timestamp with time zone;index"`
+}
+
+type RateLimitPostgresStore struct {
+	DB *gorm.DB
+}
+
+func NewRateLimitPostgresStore(db *gorm.DB) *RateLimitPostgresStore {
+	return &RateLimitPostgresStore{DB: db}
+}
+
+// Take refills and takes a token in a single statement, so concurrent requests can't take the same token
+func (store *RateLimitPostgresStore) Take(key string, limit ratelimit.Limit, now time.Time) (*ratelimit.Result, error) {
+	refilledTokens := `least(@burst, rate_limit_buckets.tokens + ` +
+		`greatest(0, extract(epoch from (@now - rate_limit_buckets.updated_at))) * @rate)`
+
+	// a zero rate never refills, so such buckets are kept
+	refilledAt := func(missingTokens string) string {
+		return `cast(@now as timestamp with time zone) + ` +
+			`(` + missingTokens + `) / nullif(cast(@rate as double precision), 0) * interval '1 second'`
+	}
+
+	var takenBuckets []*RateLimitBucket
+	err := store.DB.Raw(
+		`insert into rate_limit_buckets (key, tokens, updated_at, refilled_at)
+		values (@key, @burst - 1, @now, `+refilledAt("1")+`)
+		on conflict (key) do update set
+			tokens = `+refilledTokens+` - 1,
+			updated_at = @now,
+			refilled_at = `+refilledAt("@burst - "+refilledTokens+" + 1")+`
+		where `+refilledTokens+` >= 1
+		returning key, tokens, updated_at, refilled_at`,
+		map[string]any{"key": key, "burst": float64(limit.Burst), "rate": limit.Rate, "now": now},
+	).Scan(&takenBuckets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(takenBuckets) > 0 {
+		return &ratelimit.Result{
+			Allowed:   true,
+			Limit:     limit.Burst,
+			Remaining: int(takenBuckets[0].Tokens),
+		}, nil
+	}
+
+	// no token was available, so the stored bucket is only read to tell when the next one will be
+	storedBucket := &RateLimitBucket{}
+	err = store.DB.Where("key = ?", key).First(storedBucket).Error
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := &ratelimit.Bucket{Tokens: storedBucket.Tokens, UpdatedAt: storedBucket.UpdatedAt}
+	result := bucket.Take(limit, now)
+	// a token refilled after the update above doesn't belong to this request
+	result.Allowed = false
+	return result, nil
+}
+
+// Prune deletes the buckets refilled by now, as keys come from unauthenticated requests the table would
+// otherwise keep growing. A bucket taken from meanwhile isn't refilled anymore, so it's kept.
+func (store *RateLimitPostgresStore) Prune(now time.Time) (int64, error) {
+	result := store.DB.Where("refilled_at <= ?", now).Delete(&RateLimitBucket{})
+	return result.RowsAffected, result.Error
+}
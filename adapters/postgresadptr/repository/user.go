@@ -39,7 +39,8 @@ func (repository *UserPostgresRepository) FindById(id string) (*model.User, erro
 
 func (repository *UserPostgresRepository) FindByEmail(email string) (*model.User, error) {
 	var user model.User
-	err := repository.DB.Where("email = ?", email).First(&user).Error
+	// emails are matched case insensitively, as they're typed differently across sign in, resets and invites
+	err := repository.DB.Where("lower(email) = lower(?)", email).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserRefreshTokenPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewUserRefreshTokenPostgresRepository(db *gorm.DB) *UserRefreshTokenPostgresRepository {
+	return &UserRefreshTokenPostgresRepository{DB: db}
+}
+
+func (repository *UserRefreshTokenPostgresRepository) Create(refreshToken *model.UserRefreshToken) error {
+	return repository.DB.Omit(clause.Associations).Create(refreshToken).Error
+}
+
+func (repository *UserRefreshTokenPostgresRepository) FindByTokenHash(tokenHash string) (*model.UserRefreshToken, error) {
+	refreshToken := &model.UserRefreshToken{}
+	err := repository.DB.
+		Where("token_hash = ?", tokenHash).
+		First(refreshToken).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return refreshToken, nil
+}
+
+func (repository *UserRefreshTokenPostgresRepository) Rotate(
+	usedRefreshToken *model.UserRefreshToken,
+	nextRefreshToken *model.UserRefreshToken,
+) error {
+	return repository.DB.Transaction(func(tx *gorm.DB) error {
+		// a concurrent refresh may have used the token since it was loaded
+		result := tx.
+			Model(&model.UserRefreshToken{}).
+			Where("id = ? and used_at is null", usedRefreshToken.ID).
+			Update("used_at", usedRefreshToken.UsedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Omit(clause.Associations).Create(nextRefreshToken).Error
+	})
+}
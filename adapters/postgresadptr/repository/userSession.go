@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserSessionPostgresRepository struct {
+	DB *gorm.DB
+}
+
+func NewUserSessionPostgresRepository(db *gorm.DB) *UserSessionPostgresRepository {
+	return &UserSessionPostgresRepository{DB: db}
+}
+
+func (repository *UserSessionPostgresRepository) Create(session *model.UserSession) error {
+	return repository.DB.Omit(clause.Associations).Create(session).Error
+}
+
+func (repository *UserSessionPostgresRepository) Save(session *model.UserSession) error {
+	return repository.DB.Omit(clause.Associations).Save(session).Error
+}
+
+func (repository *UserSessionPostgresRepository) FindById(sessionId string) (*model.UserSession, error) {
+	session := &model.UserSession{}
+	err := repository.DB.
+		Where("id = ?", sessionId).
+		First(session).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (repository *UserSessionPostgresRepository) ListActiveByUser(
+	userId string,
+	now time.Time,
+) ([]*model.UserSession, error) {
+	sessions := []*model.UserSession{}
+	err := repository.DB.
+		Where("user_id = ? and revoked_at is null and expires_at > ?", userId, now).
+		Order("last_seen_at desc").
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (repository *UserSessionPostgresRepository) UpdateLastSeenAt(sessionId string, lastSeenAt time.Time) error {
+	return repository.DB.
+		Model(&model.UserSession{}).
+		Where("id = ?", sessionId).
+		Update("last_seen_at", lastSeenAt).Error
+}
+
+func (repository *UserSessionPostgresRepository) RevokeAllByUser(userId string, now time.Time) error {
+	return repository.DB.
+		Model(&model.UserSession{}).
+		Where("user_id = ? and revoked_at is null", userId).
+		Update("revoked_at", now).Error
+}
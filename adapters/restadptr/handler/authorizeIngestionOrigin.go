@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+var ingestionAllowedHeaders = []string{
+	fiber.HeaderContentType,
+	"Idempotency-Key",
+	"X-Signature",
+	"X-Signature-Timestamp",
+}
+
+const ingestionPreflightMaxAge = "600"
+
+// AuthorizeIngestionOriginHandler answers the CORS preflight of the ingestion endpoints
+type AuthorizeIngestionOriginHandler struct {
+	useCase *usecase.AuthorizeIngestionOriginUseCase
+}
+
+func NewAuthorizeIngestionOriginHandler() *AuthorizeIngestionOriginHandler {
+	db := postgresadptr.GetConnection()
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := usecase.NewAuthorizeIngestionOriginUseCase(projectApiKeyRepository)
+	return &AuthorizeIngestionOriginHandler{useCase}
+}
+
+func (handler *AuthorizeIngestionOriginHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.AuthorizeIngestionOriginRequest{
+		ApiKey: ctx.Params("apiKey"),
+		Origin: ctx.Get(fiber.HeaderOrigin),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderAccessControlAllowOrigin, req.Origin)
+	ctx.Set(fiber.HeaderAccessControlAllowMethods, fiber.MethodPost)
+	ctx.Set(fiber.HeaderAccessControlAllowHeaders, strings.Join(ingestionAllowedHeaders, ", "))
+	ctx.Set(fiber.HeaderAccessControlMaxAge, ingestionPreflightMaxAge)
+	ctx.Vary(fiber.HeaderOrigin)
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/kafkaadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BulkInviteProjectMembersHandler struct {
+	useCase *usecase.BulkInviteProjectMembersUseCase
+}
+
+func NewBulkInviteProjectMembersHandler() *BulkInviteProjectMembersHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	producerFactory := kafkaadptr.NewProducerFactory()
+	useCase := usecase.NewBulkInviteProjectMembersUseCase(
+		projectPermissionService,
+		projectRepository,
+		userRepository,
+		projectInviteRepository,
+		producerFactory,
+	)
+	return &BulkInviteProjectMembersHandler{useCase}
+}
+
+// Handle expects a multipart form with the csv in the file field
+func (handler *BulkInviteProjectMembersHandler) Handle(ctx *fiber.Ctx) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+	defer file.Close()
+
+	req := &appmodel.BulkInviteProjectMembersRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("projectId"),
+		Csv:       file,
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
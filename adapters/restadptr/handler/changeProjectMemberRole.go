@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChangeProjectMemberRoleHandler struct {
+	useCase *usecase.ChangeProjectMemberRoleUseCase
+}
+
+func NewChangeProjectMemberRoleHandler() *ChangeProjectMemberRoleHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	useCase := usecase.NewChangeProjectMemberRoleUseCase(projectPermissionService, projectMemberRepository)
+	return &ChangeProjectMemberRoleHandler{useCase}
+}
+
+func (handler *ChangeProjectMemberRoleHandler) Handle(ctx *fiber.Ctx) error {
+	req := new(appmodel.ChangeProjectMemberRoleRequest)
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+	req.ProjectID = ctx.Params("id")
+	req.MemberID = ctx.Params("memberId")
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -18,7 +18,8 @@ func NewCreateProjectHandler() *CreateProjectHandler {
 	db := postgresadptr.GetConnection()
 	projectRepository := repository.NewProjectPostgresRepository(db)
 	userRepository := repository.NewUserPostgresRepository(db)
-	useCase := *usecase.NewCreateProjectUseCase(projectRepository, userRepository)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := *usecase.NewCreateProjectUseCase(projectRepository, userRepository, projectApiKeyRepository)
 	return &CreateProjectHandler{useCase: useCase}
 }
 
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateProjectApiKeyHandler struct {
+	useCase *usecase.CreateProjectApiKeyUseCase
+}
+
+func NewCreateProjectApiKeyHandler() *CreateProjectApiKeyHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := usecase.NewCreateProjectApiKeyUseCase(projectPermissionService, projectRepository, userRepository, projectApiKeyRepository)
+	return &CreateProjectApiKeyHandler{useCase}
+}
+
+func (handler *CreateProjectApiKeyHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.CreateProjectApiKeyRequest{}
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+	req.ProjectID = ctx.Params("id")
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateProjectInviteLinkHandler struct {
+	useCase *usecase.CreateProjectInviteLinkUseCase
+}
+
+func NewCreateProjectInviteLinkHandler() *CreateProjectInviteLinkHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	projectInviteLinkRepository := repository.NewProjectInviteLinkPostgresRepository(db)
+	useCase := usecase.NewCreateProjectInviteLinkUseCase(
+		projectPermissionService,
+		projectRepository,
+		projectInviteLinkRepository,
+	)
+	return &CreateProjectInviteLinkHandler{useCase}
+}
+
+func (handler *CreateProjectInviteLinkHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.CreateProjectInviteLinkRequest{}
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+	req.ProjectID = ctx.Params("id")
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
@@ -14,8 +14,11 @@ type DeleteProjectHandler struct {
 }
 
 func NewDeleteProjectHandler() *DeleteProjectHandler {
-	projectRepository := repository.NewProjectPostgresRepository(postgresadptr.GetConnection())
-	useCase := *usecase.NewDeleteProjectUseCase(projectRepository)
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	useCase := *usecase.NewDeleteProjectUseCase(projectPermissionService, projectRepository)
 	return &DeleteProjectHandler{useCase: useCase}
 }
 
@@ -15,8 +15,11 @@ type EditProjectHandler struct {
 }
 
 func NewEditProjectHandler() *EditProjectHandler {
-	projectRepository := repository.NewProjectPostgresRepository(postgresadptr.GetConnection())
-	useCase := *usecase.NewEditProjectUseCase(projectRepository)
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	useCase := *usecase.NewEditProjectUseCase(projectPermissionService, projectRepository)
 	return &EditProjectHandler{useCase: useCase}
 }
 
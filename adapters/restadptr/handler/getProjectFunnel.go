@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetProjectFunnelHandler struct {
+	useCase usecase.GetProjectFunnelUseCase
+}
+
+func NewGetProjectFunnelHandler() *GetProjectFunnelHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	eventRepository := repository.NewEventPostgresRepository(db)
+	useCase := *usecase.NewGetProjectFunnelUseCase(projectPermissionService, eventRepository)
+	return &GetProjectFunnelHandler{useCase: useCase}
+}
+
+func (handler *GetProjectFunnelHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.GetProjectFunnelRequest{}
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.Principal = requestPrincipal(ctx)
+	req.ProjectID = ctx.Params("id")
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
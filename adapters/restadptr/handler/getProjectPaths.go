@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetProjectPathsHandler struct {
+	useCase *usecase.GetProjectPathsUseCase
+}
+
+func NewGetProjectPathsHandler() *GetProjectPathsHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	eventRepository := repository.NewEventPostgresRepository(db)
+	useCase := usecase.NewGetProjectPathsUseCase(projectPermissionService, eventRepository)
+	return &GetProjectPathsHandler{useCase}
+}
+
+func (handler *GetProjectPathsHandler) Handle(ctx *fiber.Ctx) error {
+	steps, err := strconv.Atoi(ctx.Query("steps", "0"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	limit, err := strconv.Atoi(ctx.Query("limit", "0"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	sessionTimeoutMinutes, err := strconv.Atoi(ctx.Query("session_timeout_minutes", "30"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req := &appmodel.GetProjectPathsRequest{
+		Principal:             requestPrincipal(ctx),
+		ProjectID:             ctx.Params("id"),
+		Event:                 ctx.Query("event"),
+		Direction:             ctx.Query("direction", usecase.PathDirectionForward),
+		Steps:                 steps,
+		SessionTimeoutMinutes: sessionTimeoutMinutes,
+		CollapseRepeated:      ctx.QueryBool("collapse_repeated"),
+		Limit:                 limit,
+	}
+	if from != nil {
+		req.From = *from
+	}
+	if to != nil {
+		req.To = *to
+	} else {
+		req.To = time.Now()
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetProjectRetentionHandler struct {
+	useCase *usecase.GetProjectRetentionUseCase
+}
+
+func NewGetProjectRetentionHandler() *GetProjectRetentionHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	eventRepository := repository.NewEventPostgresRepository(db)
+	useCase := usecase.NewGetProjectRetentionUseCase(projectPermissionService, eventRepository)
+	return &GetProjectRetentionHandler{useCase}
+}
+
+func (handler *GetProjectRetentionHandler) Handle(ctx *fiber.Ctx) error {
+	periods, err := strconv.Atoi(ctx.Query("periods", "0"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req := &appmodel.GetProjectRetentionRequest{
+		Principal:   requestPrincipal(ctx),
+		ProjectID:   ctx.Params("id"),
+		StartEvent:  ctx.Query("start_event"),
+		ReturnEvent: ctx.Query("return_event"),
+		Period:      ctx.Query("period", usecase.TimeIntervalDay),
+		Type:        ctx.Query("type", usecase.RetentionTypeNDay),
+		Periods:     periods,
+		Timezone:    ctx.Query("timezone"),
+	}
+	if from != nil {
+		req.From = *from
+	}
+	if to != nil {
+		req.To = *to
+	} else {
+		req.To = time.Now()
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
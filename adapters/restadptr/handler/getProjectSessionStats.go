@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetProjectSessionStatsHandler struct {
+	useCase *usecase.GetProjectSessionStatsUseCase
+}
+
+func NewGetProjectSessionStatsHandler() *GetProjectSessionStatsHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	endUserSessionRepository := repository.NewEndUserSessionPostgresRepository(db)
+	useCase := usecase.NewGetProjectSessionStatsUseCase(projectPermissionService, endUserSessionRepository)
+	return &GetProjectSessionStatsHandler{useCase}
+}
+
+func (handler *GetProjectSessionStatsHandler) Handle(ctx *fiber.Ctx) error {
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req := &appmodel.GetProjectSessionStatsRequest{
+		Principal: requestPrincipal(ctx),
+		ProjectID: ctx.Params("id"),
+	}
+	if from != nil {
+		req.From = *from
+	}
+	if to != nil {
+		req.To = *to
+	} else {
+		req.To = time.Now()
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -15,14 +15,16 @@ type GetProjectStatsHandler struct {
 
 func NewGetProjectStatsHandler() *GetProjectStatsHandler {
 	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
 	projectRepository := repository.NewProjectPostgresRepository(db)
-	useCase := *usecase.NewGetProjectStatsUseCase(projectRepository)
+	useCase := *usecase.NewGetProjectStatsUseCase(projectPermissionService, projectRepository)
 	return &GetProjectStatsHandler{useCase: useCase}
 }
 
 func (handler *GetProjectStatsHandler) Handle(ctx *fiber.Ctx) error {
 	req := &appmodel.GetProjectStatsRequest{
-		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		Principal: requestPrincipal(ctx),
 		ProjectID: ctx.Params("id"),
 	}
 
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IdentifyEndUserHandler struct {
+	useCase *usecase.IdentifyEndUserUseCase
+}
+
+func NewIdentifyEndUserHandler() *IdentifyEndUserHandler {
+	db := postgresadptr.GetConnection()
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	ingestionSignatureRepository := repository.NewIngestionSignaturePostgresRepository(db)
+	endUserIdentityRepository := repository.NewEndUserIdentityPostgresRepository(db)
+	useCase := usecase.NewIdentifyEndUserUseCase(
+		projectApiKeyRepository,
+		ingestionSignatureRepository,
+		endUserIdentityRepository,
+	)
+	return &IdentifyEndUserHandler{useCase}
+}
+
+func (handler *IdentifyEndUserHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.IdentifyEndUserRequest{}
+	if err := ctx.BodyParser(req); err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+	req.ApiKey = ctx.Params("apiKey")
+	req.Signature = parseRequestSignature(ctx)
+	req.Origin = parseRequestOrigin(ctx)
+
+	if err := validator.ValidateRequestBody(req); err != nil {
+		return err
+	}
+
+	if err := handler.useCase.Execute(req); err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -16,11 +16,14 @@ type InviteProjectMembersHandler struct {
 
 func NewInviteProjectMembersHandler() *InviteProjectMembersHandler {
 	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
 	projectRepository := repository.NewProjectPostgresRepository(db)
 	userRepository := repository.NewUserPostgresRepository(db)
 	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
 	producerFactory := kafkaadptr.NewProducerFactory()
 	useCase := *usecase.NewInviteProjectMembersUseCase(
+		projectPermissionService,
 		projectRepository,
 		userRepository,
 		projectInviteRepository,
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type JoinProjectByInviteLinkHandler struct {
+	useCase *usecase.JoinProjectByInviteLinkUseCase
+}
+
+func NewJoinProjectByInviteLinkHandler() *JoinProjectByInviteLinkHandler {
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectInviteLinkRepository := repository.NewProjectInviteLinkPostgresRepository(db)
+	useCase := usecase.NewJoinProjectByInviteLinkUseCase(userRepository, projectInviteLinkRepository)
+	return &JoinProjectByInviteLinkHandler{useCase}
+}
+
+func (handler *JoinProjectByInviteLinkHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.JoinProjectByInviteLinkRequest{}
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
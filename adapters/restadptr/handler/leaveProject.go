@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type LeaveProjectHandler struct {
+	useCase *usecase.LeaveProjectUseCase
+}
+
+func NewLeaveProjectHandler() *LeaveProjectHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	useCase := usecase.NewLeaveProjectUseCase(
+		projectPermissionService,
+		projectRepository,
+		projectMemberRepository,
+		projectInviteRepository,
+	)
+	return &LeaveProjectHandler{useCase}
+}
+
+func (handler *LeaveProjectHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.LeaveProjectRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
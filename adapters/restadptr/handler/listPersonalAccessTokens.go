@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ListPersonalAccessTokensHandler struct {
+	useCase *usecase.ListPersonalAccessTokensUseCase
+}
+
+func NewListPersonalAccessTokensHandler() *ListPersonalAccessTokensHandler {
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewListPersonalAccessTokensUseCase(personalAccessTokenRepository)
+	return &ListPersonalAccessTokensHandler{useCase}
+}
+
+func (handler *ListPersonalAccessTokensHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.ListPersonalAccessTokensRequest{
+		ActorID: ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
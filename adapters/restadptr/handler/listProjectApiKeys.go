@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ListProjectApiKeysHandler struct {
+	useCase *usecase.ListProjectApiKeysUseCase
+}
+
+func NewListProjectApiKeysHandler() *ListProjectApiKeysHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := usecase.NewListProjectApiKeysUseCase(projectPermissionService, projectApiKeyRepository)
+	return &ListProjectApiKeysHandler{useCase}
+}
+
+func (handler *ListProjectApiKeysHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.ListProjectApiKeysRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
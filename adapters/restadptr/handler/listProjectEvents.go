@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+const propertyFilterQueryPrefix = "properties."
+
+type ListProjectEventsHandler struct {
+	useCase *usecase.ListProjectEventsUseCase
+}
+
+func NewListProjectEventsHandler() *ListProjectEventsHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	eventRepository := repository.NewEventPostgresRepository(db)
+	useCase := usecase.NewListProjectEventsUseCase(projectPermissionService, eventRepository)
+	return &ListProjectEventsHandler{useCase}
+}
+
+func (handler *ListProjectEventsHandler) Handle(ctx *fiber.Ctx) error {
+	limit, err := strconv.Atoi(ctx.Query("limit", "0"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req := &appmodel.ListProjectEventsRequest{
+		Principal:  requestPrincipal(ctx),
+		ProjectID:  ctx.Params("id"),
+		Name:       ctx.Query("name"),
+		From:       from,
+		To:         to,
+		Properties: parsePropertyFilters(ctx),
+		Order:      ctx.Query("order", "desc"),
+		Cursor:     ctx.Query("cursor"),
+		Limit:      limit,
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+func parseTimeQuery(ctx *fiber.Ctx, key string) (*time.Time, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsedTime, nil
+}
+
+// property filters are sent as `properties.<key>=<value>` query params
+func parsePropertyFilters(ctx *fiber.Ctx) map[string]string {
+	filters := map[string]string{}
+	for key, value := range ctx.Queries() {
+		if strings.HasPrefix(key, propertyFilterQueryPrefix) {
+			filters[strings.TrimPrefix(key, propertyFilterQueryPrefix)] = value
+		}
+	}
+	return filters
+}
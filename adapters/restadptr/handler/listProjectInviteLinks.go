@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ListProjectInviteLinksHandler struct {
+	useCase *usecase.ListProjectInviteLinksUseCase
+}
+
+func NewListProjectInviteLinksHandler() *ListProjectInviteLinksHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectInviteLinkRepository := repository.NewProjectInviteLinkPostgresRepository(db)
+	useCase := usecase.NewListProjectInviteLinksUseCase(projectPermissionService, projectInviteLinkRepository)
+	return &ListProjectInviteLinksHandler{useCase}
+}
+
+func (handler *ListProjectInviteLinksHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.ListProjectInviteLinksRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -16,9 +16,10 @@ type ListProjectInvitesHandler struct {
 
 func NewListProjectInvitesHandler() *ListProjectInvitesHandler {
 	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
 	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
-	projectRepository := repository.NewProjectPostgresRepository(db)
-	useCase := usecase.NewListProjectInvitesUseCase(projectInviteRepository, projectRepository)
+	useCase := usecase.NewListProjectInvitesUseCase(projectInviteRepository, projectPermissionService)
 	return &ListProjectInvitesHandler{useCase}
 }
 
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ListProjectSessionsHandler struct {
+	useCase *usecase.ListProjectSessionsUseCase
+}
+
+func NewListProjectSessionsHandler() *ListProjectSessionsHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	endUserSessionRepository := repository.NewEndUserSessionPostgresRepository(db)
+	useCase := usecase.NewListProjectSessionsUseCase(projectPermissionService, endUserSessionRepository)
+	return &ListProjectSessionsHandler{useCase}
+}
+
+func (handler *ListProjectSessionsHandler) Handle(ctx *fiber.Ctx) error {
+	page, err := strconv.Atoi(ctx.Query("page", "1"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	pageSize, err := strconv.Atoi(ctx.Query("page_size", "10"))
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req := &appmodel.ListProjectSessionsRequest{
+		Principal: requestPrincipal(ctx),
+		ProjectID: ctx.Params("id"),
+		EndUserID: ctx.Query("end_user_id"),
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	if from != nil {
+		req.From = *from
+	}
+	if to != nil {
+		req.To = *to
+	} else {
+		req.To = time.Now()
+	}
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ListUserSessionsHandler struct {
+	useCase *usecase.ListUserSessionsUseCase
+}
+
+func NewListUserSessionsHandler() *ListUserSessionsHandler {
+	userSessionRepository := repository.NewUserSessionPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewListUserSessionsUseCase(userSessionRepository)
+	return &ListUserSessionsHandler{useCase}
+}
+
+func (handler *ListUserSessionsHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.ListUserSessionsRequest{
+		ActorID:          ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		CurrentSessionID: ctx.Locals("sessionId").(string),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
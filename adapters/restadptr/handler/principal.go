@@ -0,0 +1,15 @@
+package handler
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requestPrincipal returns the read api key authenticated by middleware.NewApiKeyOrUserAuth,
+// or the signed in user when no api key was sent
+func requestPrincipal(ctx *fiber.Ctx) appmodel.Principal {
+	if principal, ok := ctx.Locals("apiKeyPrincipal").(appmodel.Principal); ok {
+		return principal
+	}
+	return appmodel.Principal{UserID: ctx.Locals("sessionUser").(appmodel.AuthUser).ID}
+}
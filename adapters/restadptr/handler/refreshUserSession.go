@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RefreshUserSessionHandler struct {
+	useCase *usecase.RefreshUserSessionUseCase
+}
+
+func NewRefreshUserSessionHandler() *RefreshUserSessionHandler {
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	userSessionRepository := repository.NewUserSessionPostgresRepository(db)
+	userRefreshTokenRepository := repository.NewUserRefreshTokenPostgresRepository(db)
+	useCase := usecase.NewRefreshUserSessionUseCase(
+		userRepository,
+		userSessionRepository,
+		userRefreshTokenRepository,
+		jwt.NewDefaultManager(),
+	)
+	return &RefreshUserSessionHandler{useCase}
+}
+
+func (handler *RefreshUserSessionHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RefreshUserSessionRequest{}
+	if len(ctx.Body()) > 0 {
+		err := ctx.BodyParser(req)
+		if err != nil {
+			return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+		}
+	}
+
+	// browsers send the refresh token as a cookie, other clients in the body
+	if req.RefreshToken == "" {
+		req.RefreshToken = ctx.Cookies("refresh_token")
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		var appErr *appmodel.AppError
+		if errors.As(err, &appErr) && appErr.Type == appmodel.ErrorTypeAuthentication {
+			middleware.ExpireAuthCookies(ctx)
+		}
+		return err
+	}
+
+	middleware.SetAuthCookies(ctx, res.AccessToken, res.RefreshToken)
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
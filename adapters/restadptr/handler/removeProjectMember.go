@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RemoveProjectMemberHandler struct {
+	useCase *usecase.RemoveProjectMemberUseCase
+}
+
+func NewRemoveProjectMemberHandler() *RemoveProjectMemberHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	useCase := usecase.NewRemoveProjectMemberUseCase(
+		projectPermissionService,
+		projectRepository,
+		projectMemberRepository,
+		projectInviteRepository,
+	)
+	return &RemoveProjectMemberHandler{useCase}
+}
+
+func (handler *RemoveProjectMemberHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RemoveProjectMemberRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("id"),
+		MemberID:  ctx.Params("memberId"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/kafkaadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RequestProjectOwnershipTransferHandler struct {
+	useCase *usecase.RequestProjectOwnershipTransferUseCase
+}
+
+func NewRequestProjectOwnershipTransferHandler() *RequestProjectOwnershipTransferHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectRepository := repository.NewProjectPostgresRepository(db)
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectOwnershipTransferRepository := repository.NewProjectOwnershipTransferPostgresRepository(db)
+	producerFactory := kafkaadptr.NewProducerFactory()
+	useCase := usecase.NewRequestProjectOwnershipTransferUseCase(
+		projectPermissionService,
+		projectRepository,
+		userRepository,
+		projectOwnershipTransferRepository,
+		producerFactory,
+	)
+	return &RequestProjectOwnershipTransferHandler{useCase}
+}
+
+func (handler *RequestProjectOwnershipTransferHandler) Handle(ctx *fiber.Ctx) error {
+	req := new(appmodel.RequestProjectOwnershipTransferRequest)
+	err := ctx.BodyParser(req)
+	if err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+	req.ProjectID = ctx.Params("id")
+
+	err = validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/kafkaadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResendProjectInviteHandler struct {
+	useCase *usecase.ResendProjectInviteUseCase
+}
+
+func NewResendProjectInviteHandler() *ResendProjectInviteHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	producerFactory := kafkaadptr.NewProducerFactory()
+	useCase := usecase.NewResendProjectInviteUseCase(
+		projectPermissionService,
+		userRepository,
+		projectInviteRepository,
+		producerFactory,
+	)
+	return &ResendProjectInviteHandler{useCase}
+}
+
+func (handler *ResendProjectInviteHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.ResendProjectInviteRequest{
+		ActorID:         ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectInviteID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
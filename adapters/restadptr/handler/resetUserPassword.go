@@ -15,8 +15,11 @@ type ResetUserPassword struct {
 }
 
 func NewResetUserPassword() *ResetUserPassword {
-	userRepository := repository.NewUserPostgresRepository(postgresadptr.GetConnection())
-	useCase := *usecase.NewResetUserPasswordUseCase(userRepository)
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	userSessionRepository := repository.NewUserSessionPostgresRepository(db)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenPostgresRepository(db)
+	useCase := *usecase.NewResetUserPasswordUseCase(userRepository, userSessionRepository, personalAccessTokenRepository)
 	return &ResetUserPassword{useCase: useCase}
 }
 
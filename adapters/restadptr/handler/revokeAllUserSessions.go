@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokeAllUserSessionsHandler struct {
+	useCase *usecase.RevokeAllUserSessionsUseCase
+}
+
+func NewRevokeAllUserSessionsHandler() *RevokeAllUserSessionsHandler {
+	userSessionRepository := repository.NewUserSessionPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewRevokeAllUserSessionsUseCase(userSessionRepository)
+	return &RevokeAllUserSessionsHandler{useCase}
+}
+
+func (handler *RevokeAllUserSessionsHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RevokeAllUserSessionsRequest{
+		ActorID: ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	middleware.ExpireAuthCookies(ctx)
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokePersonalAccessTokenHandler struct {
+	useCase *usecase.RevokePersonalAccessTokenUseCase
+}
+
+func NewRevokePersonalAccessTokenHandler() *RevokePersonalAccessTokenHandler {
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewRevokePersonalAccessTokenUseCase(personalAccessTokenRepository)
+	return &RevokePersonalAccessTokenHandler{useCase}
+}
+
+func (handler *RevokePersonalAccessTokenHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RevokePersonalAccessTokenRequest{
+		ActorID: ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		TokenID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokeProjectApiKeyHandler struct {
+	useCase *usecase.RevokeProjectApiKeyUseCase
+}
+
+func NewRevokeProjectApiKeyHandler() *RevokeProjectApiKeyHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := usecase.NewRevokeProjectApiKeyUseCase(projectPermissionService, projectApiKeyRepository)
+	return &RevokeProjectApiKeyHandler{useCase}
+}
+
+func (handler *RevokeProjectApiKeyHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RevokeProjectApiKeyRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID: ctx.Params("id"),
+		ApiKeyID:  ctx.Params("apiKeyId"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
@@ -16,8 +16,9 @@ type RevokeProjectInviteHandler struct {
 func NewRevokeProjectInviteHandler() *RevokeProjectInviteHandler {
 	db := postgresadptr.GetConnection()
 	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
-	userRepository := repository.NewUserPostgresRepository(db)
-	useCase := *usecase.NewRevokeProjectInviteUseCase(projectInviteRepository, userRepository)
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	useCase := *usecase.NewRevokeProjectInviteUseCase(projectInviteRepository, projectPermissionService)
 	return &RevokeProjectInviteHandler{useCase: useCase}
 }
 
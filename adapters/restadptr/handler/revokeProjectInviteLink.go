@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokeProjectInviteLinkHandler struct {
+	useCase *usecase.RevokeProjectInviteLinkUseCase
+}
+
+func NewRevokeProjectInviteLinkHandler() *RevokeProjectInviteLinkHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	projectInviteLinkRepository := repository.NewProjectInviteLinkPostgresRepository(db)
+	useCase := usecase.NewRevokeProjectInviteLinkUseCase(projectPermissionService, projectInviteLinkRepository)
+	return &RevokeProjectInviteLinkHandler{useCase}
+}
+
+func (handler *RevokeProjectInviteLinkHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RevokeProjectInviteLinkRequest{
+		ActorID:      ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		ProjectID:    ctx.Params("id"),
+		InviteLinkID: ctx.Params("inviteLinkId"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
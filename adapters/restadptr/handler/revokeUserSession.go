@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokeUserSessionHandler struct {
+	useCase *usecase.RevokeUserSessionUseCase
+}
+
+func NewRevokeUserSessionHandler() *RevokeUserSessionHandler {
+	userSessionRepository := repository.NewUserSessionPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewRevokeUserSessionUseCase(userSessionRepository)
+	return &RevokeUserSessionHandler{useCase}
+}
+
+func (handler *RevokeUserSessionHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RevokeUserSessionRequest{
+		ActorID:   ctx.Locals("sessionUser").(appmodel.AuthUser).ID,
+		SessionID: ctx.Params("id"),
+	}
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	err = handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+
+	if req.SessionID == ctx.Locals("sessionId") {
+		middleware.ExpireAuthCookies(ctx)
+	}
+	ctx.Status(fiber.StatusNoContent)
+	return nil
+}
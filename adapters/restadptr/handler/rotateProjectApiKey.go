@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RotateProjectApiKeyHandler struct {
+	useCase *usecase.RotateProjectApiKeyUseCase
+}
+
+func NewRotateProjectApiKeyHandler() *RotateProjectApiKeyHandler {
+	db := postgresadptr.GetConnection()
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(db)
+	useCase := usecase.NewRotateProjectApiKeyUseCase(projectPermissionService, userRepository, projectApiKeyRepository)
+	return &RotateProjectApiKeyHandler{useCase}
+}
+
+func (handler *RotateProjectApiKeyHandler) Handle(ctx *fiber.Ctx) error {
+	req := &appmodel.RotateProjectApiKeyRequest{}
+	if len(ctx.Body()) > 0 {
+		err := ctx.BodyParser(req)
+		if err != nil {
+			return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+		}
+	}
+
+	req.ActorID = ctx.Locals("sessionUser").(appmodel.AuthUser).ID
+	req.ProjectID = ctx.Params("id")
+	req.ApiKeyID = ctx.Params("apiKeyId")
+
+	err := validator.ValidateRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(req)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
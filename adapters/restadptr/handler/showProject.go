@@ -16,8 +16,9 @@ type ShowProjectHandler struct {
 func NewShowProjectHandler() *ShowProjectHandler {
 	db := postgresadptr.GetConnection()
 	projectRepository := repository.NewProjectPostgresRepository(db)
-	userRepository := repository.NewUserPostgresRepository(db)
-	useCase := *usecase.NewShowProjectUseCase(projectRepository, userRepository)
+	projectMemberRepository := repository.NewProjectMemberPostgresRepository(db)
+	projectPermissionService := usecase.NewProjectPermissionService(projectMemberRepository)
+	useCase := *usecase.NewShowProjectUseCase(projectPermissionService, projectRepository)
 	return &ShowProjectHandler{useCase: useCase}
 }
 
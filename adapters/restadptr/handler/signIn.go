@@ -1,9 +1,8 @@
 package handler
 
 import (
-	"time"
-
 	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
 
 	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
@@ -19,9 +18,12 @@ type SignInHandler struct {
 }
 
 func NewSignInHandler() *SignInHandler {
-	userRepository := repository.NewUserPostgresRepository(postgresadptr.GetConnection())
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	userSessionRepository := repository.NewUserSessionPostgresRepository(db)
+	userRefreshTokenRepository := repository.NewUserRefreshTokenPostgresRepository(db)
 	jwtManager := jwt.NewDefaultManager()
-	useCase := *usecase.NewSignInUseCase(userRepository, jwtManager)
+	useCase := *usecase.NewSignInUseCase(userRepository, userSessionRepository, userRefreshTokenRepository, jwtManager)
 	return &SignInHandler{useCase: useCase}
 }
 
@@ -31,6 +33,8 @@ func (handler *SignInHandler) Handle(ctx *fiber.Ctx) error {
 	if err != nil {
 		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
 	}
+	signInRequest.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+	signInRequest.IpAddress = ctx.IP()
 
 	err = validator.ValidateRequestBody(signInRequest)
 	if err != nil {
@@ -42,12 +46,6 @@ func (handler *SignInHandler) Handle(ctx *fiber.Ctx) error {
 		return appErr
 	}
 
-	ctx.Cookie(&fiber.Cookie{
-		Name:     "access_token",
-		Value:    signInResponse.AccessToken,
-		HTTPOnly: true,
-		Path:     "/",
-		Expires:  time.Now().Add(jwt.ExpirationTime),
-	})
+	middleware.SetAuthCookies(ctx, signInResponse.AccessToken, signInResponse.RefreshToken)
 	return ctx.JSON(signInResponse)
 }
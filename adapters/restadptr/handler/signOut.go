@@ -1,18 +1,37 @@
 package handler
 
 import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
 	"github.com/gofiber/fiber/v2"
 )
 
-type SignOutHandler struct{}
+type SignOutHandler struct {
+	useCase *usecase.SignOutUseCase
+}
 
 func NewSignOutHandler() *SignOutHandler {
-	return &SignOutHandler{}
+	db := postgresadptr.GetConnection()
+	userSessionRepository := repository.NewUserSessionPostgresRepository(db)
+	userRefreshTokenRepository := repository.NewUserRefreshTokenPostgresRepository(db)
+	useCase := usecase.NewSignOutUseCase(jwt.NewDefaultManager(), userSessionRepository, userRefreshTokenRepository)
+	return &SignOutHandler{useCase}
 }
 
 func (handler *SignOutHandler) Handle(ctx *fiber.Ctx) error {
-	middleware.ExpireAccessTokenCookie(ctx)
+	err := handler.useCase.Execute(&appmodel.SignOutRequest{
+		AccessToken:  ctx.Cookies("access_token"),
+		RefreshToken: ctx.Cookies("refresh_token"),
+	})
+	if err != nil {
+		return err
+	}
+
+	middleware.ExpireAuthCookies(ctx)
 	ctx.Status(fiber.StatusNoContent)
 	return nil
 }
@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"net/http"
+	"net/url"
+
 	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
 	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
@@ -8,7 +11,6 @@ import (
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/usecase"
 	"github.com/gofiber/fiber/v2"
-	"net/http"
 )
 
 type TrackEventHandler struct {
@@ -17,9 +19,18 @@ type TrackEventHandler struct {
 
 func NewTrackEventHandler() *TrackEventHandler {
 	dbConn := postgresadptr.GetConnection()
-	projectRepository := repository.NewProjectPostgresRepository(dbConn)
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(dbConn)
+	ingestionSignatureRepository := repository.NewIngestionSignaturePostgresRepository(dbConn)
 	eventRepository := repository.NewEventPostgresRepository(dbConn)
-	useCase := usecase.NewTrackEventUseCase(projectRepository, eventRepository)
+	endUserSessionRepository := repository.NewEndUserSessionPostgresRepository(dbConn)
+	projectUsageRepository := repository.NewProjectUsagePostgresRepository(dbConn)
+	useCase := usecase.NewTrackEventUseCase(
+		projectApiKeyRepository,
+		ingestionSignatureRepository,
+		eventRepository,
+		endUserSessionRepository,
+		projectUsageRepository,
+	)
 	return &TrackEventHandler{*useCase}
 }
 
@@ -28,7 +39,12 @@ func (handler *TrackEventHandler) Handle(ctx *fiber.Ctx) error {
 	if err := ctx.BodyParser(trackEventRequest); err != nil {
 		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
 	}
-	trackEventRequest.ProjectToken = ctx.Params("projectToken")
+	trackEventRequest.ApiKey = ctx.Params("apiKey")
+	trackEventRequest.Signature = parseRequestSignature(ctx)
+	trackEventRequest.Origin = parseRequestOrigin(ctx)
+	if trackEventRequest.EventID == "" {
+		trackEventRequest.EventID = ctx.Get("Idempotency-Key")
+	}
 
 	if err := validator.ValidateRequestBody(trackEventRequest); err != nil {
 		return err
@@ -41,3 +57,32 @@ func (handler *TrackEventHandler) Handle(ctx *fiber.Ctx) error {
 	ctx.Status(http.StatusNoContent)
 	return nil
 }
+
+// server-side callers sign "<X-Signature-Timestamp>.<body>" with the project signing secret
+func parseRequestSignature(ctx *fiber.Ctx) *appmodel.RequestSignature {
+	signature := ctx.Get("X-Signature")
+	timestamp := ctx.Get("X-Signature-Timestamp")
+	if signature == "" && timestamp == "" {
+		return nil
+	}
+
+	return &appmodel.RequestSignature{
+		Signature: signature,
+		Timestamp: timestamp,
+		Body:      ctx.Body(),
+	}
+}
+
+// browsers send the Origin header on cross-origin requests, the Referer is used when it's stripped
+func parseRequestOrigin(ctx *fiber.Ctx) string {
+	origin := ctx.Get(fiber.HeaderOrigin)
+	if origin != "" {
+		return origin
+	}
+
+	referer, err := url.Parse(ctx.Get(fiber.HeaderReferer))
+	if err != nil || referer.Scheme == "" || referer.Host == "" {
+		return ""
+	}
+	return referer.Scheme + "://" + referer.Host
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
+	"github.com/RuanScherer/journey-track-api/adapters/restadptr/validator"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TrackEventBatchHandler struct {
+	useCase usecase.TrackEventBatchUseCase
+}
+
+func NewTrackEventBatchHandler() *TrackEventBatchHandler {
+	dbConn := postgresadptr.GetConnection()
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(dbConn)
+	ingestionSignatureRepository := repository.NewIngestionSignaturePostgresRepository(dbConn)
+	eventRepository := repository.NewEventPostgresRepository(dbConn)
+	endUserSessionRepository := repository.NewEndUserSessionPostgresRepository(dbConn)
+	projectUsageRepository := repository.NewProjectUsagePostgresRepository(dbConn)
+	useCase := usecase.NewTrackEventBatchUseCase(
+		projectApiKeyRepository,
+		ingestionSignatureRepository,
+		eventRepository,
+		endUserSessionRepository,
+		projectUsageRepository,
+	)
+	return &TrackEventBatchHandler{*useCase}
+}
+
+func (handler *TrackEventBatchHandler) Handle(ctx *fiber.Ctx) error {
+	trackEventBatchRequest := &appmodel.TrackEventBatchRequest{}
+	if err := ctx.BodyParser(&trackEventBatchRequest.Events); err != nil {
+		return model.NewRestApiError(fiber.StatusBadRequest, appmodel.ErrInvalidReqData)
+	}
+	trackEventBatchRequest.ApiKey = ctx.Params("apiKey")
+	trackEventBatchRequest.Signature = parseRequestSignature(ctx)
+	trackEventBatchRequest.Origin = parseRequestOrigin(ctx)
+	for _, event := range trackEventBatchRequest.Events {
+		if event != nil {
+			event.ApiKey = trackEventBatchRequest.ApiKey
+		}
+	}
+
+	if err := validator.ValidateRequestBody(trackEventBatchRequest); err != nil {
+		return err
+	}
+
+	res, err := handler.useCase.Execute(trackEventBatchRequest)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -14,8 +14,10 @@ type VerifyUserHandler struct {
 }
 
 func NewVerifyUserHandler() *VerifyUserHandler {
-	userRepository := repository.NewUserPostgresRepository(postgresadptr.GetConnection())
-	useCase := *usecase.NewVerifyUserUseCase(userRepository)
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	useCase := *usecase.NewVerifyUserUseCase(userRepository, projectInviteRepository)
 	return &VerifyUserHandler{useCase: useCase}
 }
 
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewApiKeyOrUserAuth authenticates project read endpoints with the X-Api-Key header when it's sent,
+// acting as the key itself, and falls back to the user session otherwise
+func NewApiKeyOrUserAuth() fiber.Handler {
+	projectApiKeyRepository := repository.NewProjectApiKeyPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewAuthenticateProjectApiKeyUseCase(projectApiKeyRepository)
+
+	return func(ctx *fiber.Ctx) error {
+		key := ctx.Get("X-Api-Key")
+		if key == "" {
+			return handleProjectReadAuth(ctx)
+		}
+
+		res, err := useCase.Execute(&appmodel.AuthenticateProjectApiKeyRequest{
+			Key:       key,
+			ProjectID: ctx.Params("id"),
+		})
+		if err != nil {
+			return err
+		}
+
+		ctx.Locals("apiKeyPrincipal", res.Principal)
+		return ctx.Next()
+	}
+}
@@ -1,12 +1,36 @@
 package middleware
 
 import (
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
 	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/gofiber/fiber/v2"
 )
 
+func SetAuthCookies(ctx *fiber.Ctx, accessToken string, refreshToken string) {
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "access_token",
+		Value:    accessToken,
+		HTTPOnly: true,
+		Path:     "/",
+		Expires:  time.Now().Add(jwt.ExpirationTime),
+	})
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken,
+		HTTPOnly: true,
+		Path:     "/",
+		Expires:  time.Now().Add(jwt.RefreshTokenExpirationTime),
+	})
+}
+
 func ExpireAccessTokenCookie(ctx *fiber.Ctx) {
 	// needed to expire the cookie this way due to a bug in fiber
 	// https://github.com/gofiber/fiber/issues/1127
@@ -19,18 +43,114 @@ func ExpireAccessTokenCookie(ctx *fiber.Ctx) {
 	})
 }
 
+func ExpireAuthCookies(ctx *fiber.Ctx) {
+	ExpireAccessTokenCookie(ctx)
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "refresh_token",
+		Value:    "deleted",
+		HTTPOnly: true,
+		Path:     "/",
+		Expires:  time.Now().Add(-3 * time.Second),
+	})
+}
+
+// authTarget describes what a protected route reaches, so personal access tokens can be checked against it
+type authTarget struct {
+	// projectID is empty for routes outside of any project
+	projectID          string
+	write              bool
+	managesCredentials bool
+}
+
+// HandleAuth protects routes outside of any project. It accepts the access token cookie, or an access token
+// or personal access token sent as a Bearer token, rejecting tokens restricted to a project
 func HandleAuth(ctx *fiber.Ctx) error {
-	claims, err := jwt.NewDefaultManager().GetJwtClaims(ctx.Cookies("access_token"))
+	return authenticate(ctx, authTarget{write: isWriteMethod(ctx.Method())})
+}
+
+// HandleCredentialsAuth protects routes managing tokens and sessions, which personal access tokens can't reach
+func HandleCredentialsAuth(ctx *fiber.Ctx) error {
+	return authenticate(ctx, authTarget{write: isWriteMethod(ctx.Method()), managesCredentials: true})
+}
+
+// NewProjectAuth protects routes of the project identified by the route param
+func NewProjectAuth(projectIDParam string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		return authenticate(ctx, authTarget{
+			projectID: ctx.Params(projectIDParam),
+			write:     isWriteMethod(ctx.Method()),
+		})
+	}
+}
+
+// handleProjectReadAuth is used by project read endpoints, some of which take their parameters from a request body
+func handleProjectReadAuth(ctx *fiber.Ctx) error {
+	return authenticate(ctx, authTarget{projectID: ctx.Params("id")})
+}
+
+func authenticate(ctx *fiber.Ctx, target authTarget) error {
+	authorization := ctx.Get(fiber.HeaderAuthorization)
+	if authorization == "" {
+		return authenticateUserSession(ctx, ctx.Cookies("access_token"), true)
+	}
+
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found || token == "" {
+		return appmodel.NewAppError(
+			"invalid_authorization_header",
+			"authorization header should hold a Bearer token",
+			appmodel.ErrorTypeAuthentication,
+		)
+	}
+
+	if strings.HasPrefix(token, model.PersonalAccessTokenPrefix) {
+		return authenticatePersonalAccessToken(ctx, token, target)
+	}
+	return authenticateUserSession(ctx, token, false)
+}
+
+func authenticateUserSession(ctx *fiber.Ctx, accessToken string, fromCookie bool) error {
+	userSessionRepository := repository.NewUserSessionPostgresRepository(postgresadptr.GetConnection())
+	useCase := usecase.NewAuthenticateUserSessionUseCase(jwt.NewDefaultManager(), userSessionRepository)
+	res, err := useCase.Execute(&appmodel.AuthenticateUserSessionRequest{
+		AccessToken: accessToken,
+	})
 	if err != nil {
-		ExpireAccessTokenCookie(ctx)
+		// a database failure doesn't mean the token is invalid, so the cookie is kept
+		var appErr *appmodel.AppError
+		if fromCookie && errors.As(err, &appErr) && appErr.Type == appmodel.ErrorTypeAuthentication {
+			ExpireAccessTokenCookie(ctx)
+		}
 		return err
 	}
 
-	if claims.ExpiresAt.Time.Before(time.Now()) {
-		ExpireAccessTokenCookie(ctx)
-		return fiber.NewError(fiber.StatusUnauthorized, "expired access token")
+	ctx.Locals("sessionUser", res.User)
+	ctx.Locals("sessionId", res.SessionID)
+	return ctx.Next()
+}
+
+func authenticatePersonalAccessToken(ctx *fiber.Ctx, token string, target authTarget) error {
+	db := postgresadptr.GetConnection()
+	userRepository := repository.NewUserPostgresRepository(db)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenPostgresRepository(db)
+	useCase := usecase.NewAuthenticatePersonalAccessTokenUseCase(userRepository, personalAccessTokenRepository)
+
+	res, err := useCase.Execute(&appmodel.AuthenticatePersonalAccessTokenRequest{
+		Token:              token,
+		ProjectID:          target.projectID,
+		Write:              target.write,
+		ManagesCredentials: target.managesCredentials,
+	})
+	if err != nil {
+		return err
 	}
 
-	ctx.Locals("sessionUser", claims.User)
+	// personal access tokens aren't bound to any session
+	ctx.Locals("sessionUser", res.User)
+	ctx.Locals("sessionId", "")
 	return ctx.Next()
 }
+
+func isWriteMethod(method string) bool {
+	return method != fiber.MethodGet && method != fiber.MethodHead && method != fiber.MethodOptions
+}
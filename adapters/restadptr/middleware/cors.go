@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+var ingestionPathRegex = regexp.MustCompile(`^/api/v1/projects/[^/]+/(events|events/batch|identify)/?$`)
+
+// NewDashboardCors applies the dashboard CORS policy to every route but the ingestion ones,
+// which check the origin against the project allowed origins
+func NewDashboardCors(allowedOrigins []string) fiber.Handler {
+	return cors.New(cors.Config{
+		Next:             isIngestionRequest,
+		AllowOrigins:     strings.Join(allowedOrigins, ","),
+		AllowCredentials: true,
+	})
+}
+
+// HandleIngestionCors exposes the response of ingestion requests to the browser origin, which was already
+// checked against the project allowed origins when the request succeeds
+func HandleIngestionCors(ctx *fiber.Ctx) error {
+	err := ctx.Next()
+
+	origin := ctx.Get(fiber.HeaderOrigin)
+	if err == nil && origin != "" {
+		ctx.Set(fiber.HeaderAccessControlAllowOrigin, origin)
+		ctx.Vary(fiber.HeaderOrigin)
+	}
+	return err
+}
+
+func isIngestionRequest(ctx *fiber.Ctx) bool {
+	if !ingestionPathRegex.MatchString(ctx.Path()) {
+		return false
+	}
+
+	if ctx.Method() == fiber.MethodOptions {
+		return ctx.Get(fiber.HeaderAccessControlRequestMethod) == fiber.MethodPost
+	}
+	return ctx.Method() == fiber.MethodPost
+}
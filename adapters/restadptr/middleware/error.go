@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"math"
+	"strconv"
+
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/model"
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/gofiber/fiber/v2"
@@ -11,6 +14,12 @@ func HandleError(ctx *fiber.Ctx, err error) error {
 		return ctx.Status(err.StatusCode).JSON(err)
 	}
 
+	if err, ok := err.(*appmodel.LimitExceededError); ok {
+		setLimitExceededHeaders(ctx, err)
+		statusCode := getStatusCodeFromAppError(err.AppError)
+		return ctx.Status(statusCode).JSON(model.NewRestApiError(statusCode, err.AppError))
+	}
+
 	if err, ok := err.(*appmodel.AppError); ok {
 		statusCode := getStatusCodeFromAppError(err)
 		return ctx.Status(statusCode).JSON(model.NewRestApiError(statusCode, err))
@@ -40,7 +49,23 @@ func getStatusCodeFromAppError(err *appmodel.AppError) int {
 		return fiber.StatusInternalServerError
 	case appmodel.ErrorTypeAuthentication:
 		return fiber.StatusUnauthorized
+	case appmodel.ErrorTypeForbidden:
+		return fiber.StatusForbidden
+	case appmodel.ErrorTypeTooManyRequests:
+		return fiber.StatusTooManyRequests
 	default:
 		return fiber.StatusInternalServerError
 	}
 }
+
+func setLimitExceededHeaders(ctx *fiber.Ctx, err *appmodel.LimitExceededError) {
+	headerPrefix := "X-RateLimit-"
+	if err.Kind == appmodel.LimitKindQuota {
+		headerPrefix = "X-Quota-"
+	}
+
+	ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(err.RetryAfter.Seconds()))))
+	ctx.Set(headerPrefix+"Limit", strconv.Itoa(err.Limit))
+	ctx.Set(headerPrefix+"Remaining", "0")
+	ctx.Set(headerPrefix+"Reset", strconv.FormatInt(err.ResetAt.Unix(), 10))
+}
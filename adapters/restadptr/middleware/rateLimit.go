@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/ratelimit"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IngestionRateLimits struct {
+	PerApiKey ratelimit.Limit
+	PerIp     ratelimit.Limit
+}
+
+// NewIngestionRateLimit limits ingestion requests per api key and per source ip. Requests are let through when
+// the store fails, so an unavailable store doesn't stop ingestion.
+func NewIngestionRateLimit(store ratelimit.Store, limits IngestionRateLimits) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		now := time.Now()
+		// the ip is checked first, so a single source can't spend the tokens or create the buckets of
+		// arbitrary api keys
+		ipResult, err := store.Take("ingestion:ip:"+ctx.IP(), limits.PerIp, now)
+		if err != nil {
+			slog.Error("Unable to check ip rate limit", "error", err)
+			return ctx.Next()
+		}
+		if !ipResult.Allowed {
+			return newRateLimitExceededError(ipResult, now)
+		}
+
+		apiKeyResult, err := store.Take("ingestion:api_key:"+model.HashProjectApiKey(ctx.Params("apiKey")), limits.PerApiKey, now)
+		if err != nil {
+			slog.Error("Unable to check api key rate limit", "error", err)
+			return ctx.Next()
+		}
+		if !apiKeyResult.Allowed {
+			return newRateLimitExceededError(apiKeyResult, now)
+		}
+
+		tightestResult := apiKeyResult
+		if ipResult.Remaining < apiKeyResult.Remaining {
+			tightestResult = ipResult
+		}
+		ctx.Set("X-RateLimit-Limit", strconv.Itoa(tightestResult.Limit))
+		ctx.Set("X-RateLimit-Remaining", strconv.Itoa(tightestResult.Remaining))
+		return ctx.Next()
+	}
+}
+
+func newRateLimitExceededError(result *ratelimit.Result, now time.Time) error {
+	return appmodel.NewLimitExceededError(
+		"rate_limit_exceeded",
+		"too many requests, retry later",
+		appmodel.LimitKindRateLimit,
+		result.Limit,
+		now.Add(result.RetryAfter),
+		result.RetryAfter,
+	)
+}
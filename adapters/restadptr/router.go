@@ -1,8 +1,12 @@
 package restadptr
 
 import (
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/handler"
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
+	"github.com/RuanScherer/journey-track-api/application/ratelimit"
+	"github.com/RuanScherer/journey-track-api/config"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -12,6 +16,7 @@ func RegisterRoutes(app *fiber.App) {
 
 	v1.Post("/signin", handler.NewSignInHandler().Handle)
 	v1.Post("/signout", handler.NewSignOutHandler().Handle)
+	v1.Post("/token/refresh", handler.NewRefreshUserSessionHandler().Handle)
 
 	v1.Post("/users/request-password-reset", handler.NewRequestUserPasswordResetHandler().Handle)
 	v1.Patch("/users/:id/reset-password/:token", handler.NewResetUserPassword().Handle)
@@ -20,26 +25,113 @@ func RegisterRoutes(app *fiber.App) {
 
 	v1.Get("/projects/:projectId/invites/:token", handler.NewShowInvitationByProjectAndTokenHandler().Handle)
 
-	// TODO: improve endpoint by requiring some kind of authentication
-	v1.Post("/projects/:projectToken/events", handler.NewTrackEventHandler().Handle)
+	// ingestion endpoints check the browser origin against the project allowed origins
+	authorizeIngestionOriginHandler := handler.NewAuthorizeIngestionOriginHandler()
+	v1.Options("/projects/:apiKey/events", authorizeIngestionOriginHandler.Handle)
+	v1.Options("/projects/:apiKey/events/batch", authorizeIngestionOriginHandler.Handle)
+	v1.Options("/projects/:apiKey/identify", authorizeIngestionOriginHandler.Handle)
+	ingestionRateLimit := newIngestionRateLimit()
+	v1.Post(
+		"/projects/:apiKey/events",
+		middleware.HandleIngestionCors,
+		ingestionRateLimit,
+		handler.NewTrackEventHandler().Handle,
+	)
+	v1.Post(
+		"/projects/:apiKey/events/batch",
+		middleware.HandleIngestionCors,
+		ingestionRateLimit,
+		handler.NewTrackEventBatchHandler().Handle,
+	)
+	v1.Post(
+		"/projects/:apiKey/identify",
+		middleware.HandleIngestionCors,
+		ingestionRateLimit,
+		handler.NewIdentifyEndUserHandler().Handle,
+	)
 
-	// auth middleware - separate protected routes
-	api.Use(middleware.HandleAuth)
+	// read endpoints accept either a project api key with read scope or the user session
+	apiKeyOrUserAuth := middleware.NewApiKeyOrUserAuth()
+	v1.Get("/projects/:id/stats", apiKeyOrUserAuth, handler.NewGetProjectStatsHandler().Handle)
+	v1.Get("/projects/:id/stats/timeseries", apiKeyOrUserAuth, handler.NewGetProjectEventsTimeSeriesHandler().Handle)
+	v1.Post("/projects/:id/stats/funnel", apiKeyOrUserAuth, handler.NewGetProjectFunnelHandler().Handle)
+	v1.Get("/projects/:id/stats/retention", apiKeyOrUserAuth, handler.NewGetProjectRetentionHandler().Handle)
+	v1.Get("/projects/:id/stats/paths", apiKeyOrUserAuth, handler.NewGetProjectPathsHandler().Handle)
+	v1.Get("/projects/:id/stats/sessions", apiKeyOrUserAuth, handler.NewGetProjectSessionStatsHandler().Handle)
+	v1.Get("/projects/:id/events", apiKeyOrUserAuth, handler.NewListProjectEventsHandler().Handle)
+	v1.Get("/projects/:id/sessions", apiKeyOrUserAuth, handler.NewListProjectSessionsHandler().Handle)
 
-	v1.Put("/users/edit-profile", handler.NewEditUserHandler().Handle)
-	v1.Get("/users/profile", handler.NewShowUserHandler().Handle)
-	v1.Get("/users/search", handler.NewSearchUsersHandler().Handle)
+	// protected routes - personal access tokens restricted to a project only reach that project routes
+	userAuth := middleware.HandleAuth
+	projectAuth := middleware.NewProjectAuth("id")
+	invitedProjectAuth := middleware.NewProjectAuth("projectId")
 
-	v1.Post("/projects/create", handler.NewCreateProjectHandler().Handle)
-	v1.Put("/projects/:id/edit", handler.NewEditProjectHandler().Handle)
-	v1.Get("/projects/:id", handler.NewShowProjectHandler().Handle)
-	v1.Get("/projects/:id/stats", handler.NewGetProjectStatsHandler().Handle)
-	v1.Get("/projects", handler.NewListProjectsByMemberHandler().Handle)
-	v1.Delete("/projects/:id", handler.NewDeleteProjectHandler().Handle)
+	v1.Put("/users/edit-profile", userAuth, handler.NewEditUserHandler().Handle)
+	v1.Get("/users/profile", userAuth, handler.NewShowUserHandler().Handle)
+	v1.Get("/users/search", userAuth, handler.NewSearchUsersHandler().Handle)
 
-	v1.Get("/projects/:projectId/invites", handler.NewListProjectInvitesHandler().Handle)
-	v1.Post("/projects/:projectId/invite", handler.NewInviteProjectMembersHandler().Handle)
-	v1.Patch("/projects/:projectId/invites/accept", handler.NewAcceptProjectInviteHandler().Handle)
-	v1.Patch("/projects/:projectId/invites/decline", handler.NewDeclineProjectInviteHandler().Handle)
-	v1.Delete("/projects/invites/:id/revoke", handler.NewRevokeProjectInviteHandler().Handle)
+	userSessions := v1.Group("/users/sessions", middleware.HandleCredentialsAuth)
+	userSessions.Get("", handler.NewListUserSessionsHandler().Handle)
+	userSessions.Delete("", handler.NewRevokeAllUserSessionsHandler().Handle)
+	userSessions.Delete("/:id", handler.NewRevokeUserSessionHandler().Handle)
+
+	userTokens := v1.Group("/users/tokens", middleware.HandleCredentialsAuth)
+	userTokens.Post("", handler.NewCreatePersonalAccessTokenHandler().Handle)
+	userTokens.Get("", handler.NewListPersonalAccessTokensHandler().Handle)
+	userTokens.Delete("/:id", handler.NewRevokePersonalAccessTokenHandler().Handle)
+
+	v1.Post("/projects/create", userAuth, handler.NewCreateProjectHandler().Handle)
+	v1.Put("/projects/:id/edit", projectAuth, handler.NewEditProjectHandler().Handle)
+	v1.Get("/projects/:id", projectAuth, handler.NewShowProjectHandler().Handle)
+	v1.Get("/projects", userAuth, handler.NewListProjectsByMemberHandler().Handle)
+	v1.Delete("/projects/:id", projectAuth, handler.NewDeleteProjectHandler().Handle)
+	v1.Post("/projects/:id/signing-secret/rotate", projectAuth, handler.NewRotateProjectSigningSecretHandler().Handle)
+	v1.Patch("/projects/:id/members/:memberId/role", projectAuth, handler.NewChangeProjectMemberRoleHandler().Handle)
+	v1.Delete("/projects/:id/members/:memberId", projectAuth, handler.NewRemoveProjectMemberHandler().Handle)
+	v1.Post("/projects/:id/leave", projectAuth, handler.NewLeaveProjectHandler().Handle)
+	v1.Get("/projects/:id/ownership-transfers", projectAuth, handler.NewListProjectOwnershipTransfersHandler().Handle)
+	v1.Post("/projects/:id/ownership-transfers", projectAuth, handler.NewRequestProjectOwnershipTransferHandler().Handle)
+	v1.Patch(
+		"/projects/:id/ownership-transfers/confirm",
+		projectAuth,
+		handler.NewConfirmProjectOwnershipTransferHandler().Handle,
+	)
+
+	v1.Get("/projects/:id/api-keys", projectAuth, handler.NewListProjectApiKeysHandler().Handle)
+	v1.Post("/projects/:id/api-keys", projectAuth, handler.NewCreateProjectApiKeyHandler().Handle)
+	v1.Post("/projects/:id/api-keys/:apiKeyId/rotate", projectAuth, handler.NewRotateProjectApiKeyHandler().Handle)
+	v1.Delete("/projects/:id/api-keys/:apiKeyId", projectAuth, handler.NewRevokeProjectApiKeyHandler().Handle)
+
+	v1.Get("/projects/:projectId/invites", invitedProjectAuth, handler.NewListProjectInvitesHandler().Handle)
+	v1.Post("/projects/:projectId/invite", invitedProjectAuth, handler.NewInviteProjectMembersHandler().Handle)
+	v1.Post("/projects/:projectId/invite/csv", invitedProjectAuth, handler.NewBulkInviteProjectMembersHandler().Handle)
+	v1.Patch("/projects/:projectId/invites/accept", invitedProjectAuth, handler.NewAcceptProjectInviteHandler().Handle)
+	v1.Patch("/projects/:projectId/invites/decline", invitedProjectAuth, handler.NewDeclineProjectInviteHandler().Handle)
+	v1.Delete("/projects/invites/:id/revoke", userAuth, handler.NewRevokeProjectInviteHandler().Handle)
+	v1.Post("/projects/invites/:id/resend", userAuth, handler.NewResendProjectInviteHandler().Handle)
+
+	v1.Get("/projects/:id/invite-links", projectAuth, handler.NewListProjectInviteLinksHandler().Handle)
+	v1.Post("/projects/:id/invite-links", projectAuth, handler.NewCreateProjectInviteLinkHandler().Handle)
+	v1.Delete("/projects/:id/invite-links/:inviteLinkId", projectAuth, handler.NewRevokeProjectInviteLinkHandler().Handle)
+	v1.Post("/projects/invite-links/join", userAuth, handler.NewJoinProjectByInviteLinkHandler().Handle)
+}
+
+func newIngestionRateLimit() fiber.Handler {
+	appConfig := config.GetAppConfig()
+
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if appConfig.RateLimitStore == "postgres" {
+		store = repository.NewRateLimitPostgresStore(postgresadptr.GetConnection())
+	}
+
+	return middleware.NewIngestionRateLimit(store, middleware.IngestionRateLimits{
+		PerApiKey: ratelimit.Limit{
+			Rate:  appConfig.IngestionRateLimitPerApiKey,
+			Burst: appConfig.IngestionRateLimitPerApiKeyBurst,
+		},
+		PerIp: ratelimit.Limit{
+			Rate:  appConfig.IngestionRateLimitPerIp,
+			Burst: appConfig.IngestionRateLimitPerIpBurst,
+		},
+	})
 }
@@ -6,7 +6,6 @@ import (
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr/middleware"
 	"github.com/RuanScherer/journey-track-api/config"
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 )
 
@@ -18,11 +17,9 @@ func StartServer() {
 	})
 
 	app.Use(logger.New())
-	if appConfig.Environment == "development" {
-		app.Use(cors.New(cors.Config{
-			AllowOrigins:     "http://localhost:3000",
-			AllowCredentials: true,
-		}))
+	corsAllowedOrigins := appConfig.GetCorsAllowedOrigins()
+	if len(corsAllowedOrigins) > 0 {
+		app.Use(middleware.NewDashboardCors(corsAllowedOrigins))
 	}
 
 	RegisterRoutes(app)
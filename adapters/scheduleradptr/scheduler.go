@@ -0,0 +1,59 @@
+package scheduleradptr
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/adapters/kafkaadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/postgresadptr/repository"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/usecase"
+	"github.com/RuanScherer/journey-track-api/config"
+)
+
+// StartScheduler runs the background jobs of the api, a job with a non positive interval is disabled
+func StartScheduler() {
+	appConfig := config.GetAppConfig()
+
+	db := postgresadptr.GetConnection()
+	projectInviteRepository := repository.NewProjectInvitePostgresRepository(db)
+	producerFactory := kafkaadptr.NewProducerFactory()
+	sendProjectInviteReminders := usecase.NewSendProjectInviteRemindersUseCase(projectInviteRepository, producerFactory)
+	go schedule("send project invite reminders", appConfig.ProjectInviteReminderInterval, func(now time.Time) error {
+		res, err := sendProjectInviteReminders.Execute(&appmodel.SendProjectInviteRemindersRequest{Now: now})
+		if err != nil {
+			return err
+		}
+		slog.Info("Project invite reminders sent", "expired", res.ExpiredCount, "reminded", res.RemindedCount)
+		return nil
+	})
+
+	if appConfig.RateLimitStore == "postgres" {
+		rateLimitStore := repository.NewRateLimitPostgresStore(db)
+		go schedule("prune rate limit buckets", appConfig.RateLimitPruneInterval, func(now time.Time) error {
+			prunedCount, err := rateLimitStore.Prune(now)
+			if err != nil {
+				return err
+			}
+			slog.Info("Rate limit buckets pruned", "pruned", prunedCount)
+			return nil
+		})
+	}
+}
+
+func schedule(name string, interval time.Duration, run func(now time.Time) error) {
+	if interval <= 0 {
+		slog.Info("Scheduled job disabled", "job", name)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		err := run(now)
+		if err != nil {
+			slog.Error("Scheduled job failed", "job", name, "error", err)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package factory
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+func NewProjectApiKeyWithDefaultProject(scope string) (*model.ProjectApiKey, string, error) {
+	project, err := NewProjectWithDefaultOwner("fake project")
+	if err != nil {
+		return nil, "", err
+	}
+
+	return model.NewProjectApiKey(project, "fake api key", scope, project.Memberships[0].User)
+}
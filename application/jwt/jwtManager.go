@@ -1,6 +1,9 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -11,12 +14,18 @@ import (
 )
 
 const (
-	ExpirationTime = time.Hour * 24 * 7
+	ExpirationTime = 15 * time.Minute
+	// refresh tokens live as long as the session they renew access tokens for
+	RefreshTokenExpirationTime = model.UserSessionTTL
 )
 
 type Manager interface {
-	CreateJwtFromUser(user *model.User) (string, error)
+	// CreateJwtFromUser issues a token for the user session, referenced by the jti claim
+	CreateJwtFromUser(user *model.User, session *model.UserSession) (string, error)
 	GetJwtClaims(token string) (*appmodel.JwtClaims, error)
+	// CreateRefreshToken returns an opaque refresh token along with the hash it's stored as
+	CreateRefreshToken() (string, string, error)
+	HashRefreshToken(token string) string
 }
 
 type DefaultManager struct{}
@@ -25,7 +34,13 @@ func NewDefaultManager() *DefaultManager {
 	return &DefaultManager{}
 }
 
-func (manager *DefaultManager) CreateJwtFromUser(user *model.User) (string, error) {
+func (manager *DefaultManager) CreateJwtFromUser(user *model.User, session *model.UserSession) (string, error) {
+	now := time.Now()
+	expiresAt := now.Add(ExpirationTime)
+	if session.ExpiresAt.Before(expiresAt) {
+		expiresAt = session.ExpiresAt
+	}
+
 	jwtClaims := appmodel.JwtClaims{
 		User: appmodel.AuthUser{
 			ID:    user.ID,
@@ -33,8 +48,9 @@ func (manager *DefaultManager) CreateJwtFromUser(user *model.User) (string, erro
 			Name:  user.Name,
 		},
 		RegisteredClaims: jwt.RegisteredClaims{
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ExpirationTime)),
+			ID:        session.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims)
@@ -71,3 +87,19 @@ func (manager *DefaultManager) GetJwtClaims(token string) (*appmodel.JwtClaims,
 
 	return claims, nil
 }
+
+func (manager *DefaultManager) CreateRefreshToken() (string, string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", "", errors.New("error creating refresh token")
+	}
+
+	token := hex.EncodeToString(randomBytes)
+	return token, manager.HashRefreshToken(token), nil
+}
+
+func (manager *DefaultManager) HashRefreshToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
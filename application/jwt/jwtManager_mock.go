@@ -41,18 +41,34 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // CreateJwtFromUser mocks base method.
-func (m *MockManager) CreateJwtFromUser(user *model0.User) (string, error) {
+func (m *MockManager) CreateJwtFromUser(user *model0.User, session *model0.UserSession) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateJwtFromUser", user)
+	ret := m.ctrl.Call(m, "CreateJwtFromUser", user, session)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateJwtFromUser indicates an expected call of CreateJwtFromUser.
-func (mr *MockManagerMockRecorder) CreateJwtFromUser(user any) *gomock.Call {
+func (mr *MockManagerMockRecorder) CreateJwtFromUser(user, session any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJwtFromUser", reflect.TypeOf((*MockManager)(nil).CreateJwtFromUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJwtFromUser", reflect.TypeOf((*MockManager)(nil).CreateJwtFromUser), user, session)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockManager) CreateRefreshToken() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockManagerMockRecorder) CreateRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockManager)(nil).CreateRefreshToken))
 }
 
 // GetJwtClaims mocks base method.
@@ -69,3 +85,17 @@ func (mr *MockManagerMockRecorder) GetJwtClaims(token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwtClaims", reflect.TypeOf((*MockManager)(nil).GetJwtClaims), token)
 }
+
+// HashRefreshToken mocks base method.
+func (m *MockManager) HashRefreshToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRefreshToken", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRefreshToken indicates an expected call of HashRefreshToken.
+func (mr *MockManagerMockRecorder) HashRefreshToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRefreshToken", reflect.TypeOf((*MockManager)(nil).HashRefreshToken), token)
+}
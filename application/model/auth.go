@@ -1,6 +1,10 @@
 package model
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type JwtClaims struct {
 	User AuthUser `json:"user"`
@@ -12,3 +16,112 @@ type AuthUser struct {
 	Email string `json:"email"`
 	Name  string `json:"name"`
 }
+
+// Principal is who a project read request acts as, either a signed in user or a read api key
+type Principal struct {
+	UserID string
+	// ApiKeyID is set for requests made with a read api key, which only reaches its own project
+	ApiKeyID        string
+	ApiKeyProjectID string
+}
+
+type AuthenticateUserSessionRequest struct {
+	AccessToken string `json:"-"`
+}
+
+type AuthenticateUserSessionResponse struct {
+	User      AuthUser `json:"user"`
+	SessionID string   `json:"session_id"`
+}
+
+type SignOutRequest struct {
+	AccessToken string `json:"-"`
+	// RefreshToken finds the session when the access token already expired
+	RefreshToken string `json:"-"`
+}
+
+type RefreshUserSessionRequest struct {
+	RefreshToken string `json:"refresh_token" valid:"required~refresh token is required"`
+}
+
+type RefreshUserSessionResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ListUserSessionsRequest struct {
+	ActorID string `json:"-" valid:"required~actor id is required"`
+	// CurrentSessionID flags the session making the request
+	CurrentSessionID string `json:"-" valid:"-"`
+}
+
+type ListUserSessionsResponse = []*UserSession
+
+type UserSession struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IpAddress  string    `json:"ip_address"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type RevokeUserSessionRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	SessionID string `json:"session_id" valid:"required~session id is required"`
+}
+
+type RevokeAllUserSessionsRequest struct {
+	ActorID string `json:"-" valid:"required~actor id is required"`
+}
+
+type AuthenticatePersonalAccessTokenRequest struct {
+	Token string `json:"-"`
+	// ProjectID is the project the request targets, empty for requests outside of any project
+	ProjectID string `json:"-"`
+	Write     bool   `json:"-"`
+	// ManagesCredentials flags requests to manage tokens or sessions, which need a signed in user
+	ManagesCredentials bool `json:"-"`
+}
+
+type AuthenticatePersonalAccessTokenResponse struct {
+	User    AuthUser `json:"user"`
+	TokenID string   `json:"token_id"`
+}
+
+type PersonalAccessToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scope      string     `json:"scope"`
+	ProjectID  *string    `json:"project_id"`
+	Prefix     string     `json:"prefix"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	ActorID       string  `json:"-" valid:"required~actor id is required"`
+	Name          string  `json:"name" valid:"required~name is required"`
+	Scope         string  `json:"scope" valid:"required~scope is required,in(read|write)~scope should be read or write"`
+	ProjectID     *string `json:"project_id" valid:"-"`
+	ExpiresInDays int     `json:"expires_in_days" valid:"required~expires in days is required"`
+}
+
+// CreatePersonalAccessTokenResponse carries the plain token, which can't be retrieved again
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessToken *PersonalAccessToken `json:"personal_access_token"`
+	Token               string               `json:"token"`
+}
+
+type ListPersonalAccessTokensRequest struct {
+	ActorID string `json:"-" valid:"required~actor id is required"`
+}
+
+type ListPersonalAccessTokensResponse = []*PersonalAccessToken
+
+type RevokePersonalAccessTokenRequest struct {
+	ActorID string `json:"-" valid:"required~actor id is required"`
+	TokenID string `json:"token_id" valid:"required~token id is required"`
+}
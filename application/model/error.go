@@ -8,11 +8,13 @@ import (
 var (
 	ErrInvalidReqData = NewAppError("invalid_request_data", "Invalid request data", ErrorTypeRequest)
 
-	ErrorTypeValidation     = "validation"
-	ErrorTypeDatabase       = "database"
-	ErrorTypeRequest        = "request"
-	ErrorTypeServer         = "server"
-	ErrorTypeAuthentication = "authentication"
+	ErrorTypeValidation      = "validation"
+	ErrorTypeDatabase        = "database"
+	ErrorTypeRequest         = "request"
+	ErrorTypeServer          = "server"
+	ErrorTypeAuthentication  = "authentication"
+	ErrorTypeForbidden       = "forbidden"
+	ErrorTypeTooManyRequests = "too_many_requests"
 )
 
 type AppError struct {
@@ -1,6 +1,188 @@
 package model
 
+import "time"
+
+const (
+	TrackEventBatchResultAccepted = "accepted"
+	TrackEventBatchResultRejected = "rejected"
+)
+
+// RequestSignature holds the HMAC signature sent by server-side callers along with the exact signed body
+type RequestSignature struct {
+	Signature string
+	Timestamp string
+	Body      []byte
+}
+
 type TrackEventRequest struct {
-	ProjectToken string `json:"project_token" valid:"required"`
-	Name         string `json:"name" valid:"required"`
+	ApiKey      string            `json:"api_key" valid:"required"`
+	Signature   *RequestSignature `json:"-" valid:"-"`
+	Origin      string            `json:"-" valid:"-"`
+	EventID     string            `json:"event_id" valid:"-"`
+	DistinctID  string            `json:"distinct_id" valid:"-"`
+	AnonymousID string            `json:"anonymous_id" valid:"-"`
+	SessionID   string            `json:"session_id" valid:"-"`
+	Name        string            `json:"name" valid:"required"`
+	Properties  map[string]any    `json:"properties" valid:"-"`
+	Timestamp   *time.Time        `json:"timestamp" valid:"-"`
+	SentAt      *time.Time        `json:"sent_at" valid:"-"`
+}
+
+type IdentifyEndUserRequest struct {
+	ApiKey      string            `json:"api_key" valid:"required"`
+	Signature   *RequestSignature `json:"-" valid:"-"`
+	Origin      string            `json:"-" valid:"-"`
+	AnonymousID string            `json:"anonymous_id" valid:"required~anonymous id is required"`
+	DistinctID  string            `json:"distinct_id" valid:"required~distinct id is required"`
+}
+
+type TrackEventBatchRequest struct {
+	ApiKey    string               `json:"api_key" valid:"required"`
+	Signature *RequestSignature    `json:"-" valid:"-"`
+	Origin    string               `json:"-" valid:"-"`
+	Events    []*TrackEventRequest `json:"events" valid:"-"`
+}
+
+type AuthorizeIngestionOriginRequest struct {
+	ApiKey string `json:"api_key" valid:"required"`
+	Origin string `json:"origin" valid:"required~origin is required"`
+}
+
+type TrackEventBatchResponse struct {
+	Accepted int                      `json:"accepted"`
+	Rejected int                      `json:"rejected"`
+	Results  []*TrackEventBatchResult `json:"results"`
+}
+
+type TrackEventBatchResult struct {
+	Index  int       `json:"index"`
+	Status string    `json:"status"`
+	Error  *AppError `json:"error,omitempty"`
+}
+
+type ListProjectEventsRequest struct {
+	Principal  Principal         `json:"-" valid:"-"`
+	ProjectID  string            `json:"project_id" valid:"required"`
+	Name       string            `json:"name"`
+	From       *time.Time        `json:"from" valid:"-"`
+	To         *time.Time        `json:"to" valid:"-"`
+	Properties map[string]string `json:"properties" valid:"-"`
+	Order      string            `json:"order" valid:"in(asc|desc)~order should be asc or desc"`
+	Cursor     string            `json:"cursor"`
+	Limit      int               `json:"limit"`
+}
+
+type ListProjectEventsResponse struct {
+	Events     []*ProjectEvent `json:"events"`
+	NextCursor *string         `json:"next_cursor"`
+}
+
+type ProjectEvent struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Timestamp       time.Time      `json:"timestamp"`
+	ClientTimestamp *time.Time     `json:"client_timestamp"`
+	ReceivedAt      time.Time      `json:"received_at"`
+	ClientEventID   *string        `json:"client_event_id"`
+	DistinctID      *string        `json:"distinct_id"`
+	AnonymousID     *string        `json:"anonymous_id"`
+	SessionID       *string        `json:"session_id"`
+	Properties      map[string]any `json:"properties"`
+}
+
+type GetProjectFunnelRequest struct {
+	Principal            Principal     `json:"-" valid:"-"`
+	ProjectID            string        `json:"project_id" valid:"required"`
+	Steps                []*FunnelStep `json:"steps" valid:"-"`
+	ConversionWindowDays int           `json:"conversion_window_days"`
+	From                 time.Time     `json:"from" valid:"required~from is required"`
+	To                   time.Time     `json:"to" valid:"required~to is required"`
+}
+
+type FunnelStep struct {
+	Name       string         `json:"name"`
+	Properties map[string]any `json:"properties"`
+}
+
+type GetProjectFunnelResponse struct {
+	ConversionWindowDays int                 `json:"conversion_window_days"`
+	Steps                []*FunnelStepResult `json:"steps"`
+}
+
+type FunnelStepResult struct {
+	Name                          string   `json:"name"`
+	Count                         int      `json:"count"`
+	ConversionRate                float64  `json:"conversion_rate"`
+	StepConversionRate            float64  `json:"step_conversion_rate"`
+	MedianSecondsFromPreviousStep *float64 `json:"median_seconds_from_previous_step"`
+}
+
+type GetProjectRetentionRequest struct {
+	Principal   Principal `json:"-" valid:"-"`
+	ProjectID   string    `json:"project_id" valid:"required"`
+	StartEvent  string    `json:"start_event" valid:"required~start event is required"`
+	ReturnEvent string    `json:"return_event" valid:"required~return event is required"`
+	Period      string    `json:"period" valid:"required~period is required,in(day|week|month)~invalid period"`
+	Type        string    `json:"type" valid:"required~type is required,in(n_day|unbounded)~invalid retention type"`
+	Periods     int       `json:"periods"`
+	From        time.Time `json:"from" valid:"required~from is required"`
+	To          time.Time `json:"to" valid:"required~to is required"`
+	Timezone    string    `json:"timezone"`
+}
+
+type GetProjectRetentionResponse struct {
+	Period   string             `json:"period"`
+	Type     string             `json:"type"`
+	Timezone string             `json:"timezone"`
+	Cohorts  []*RetentionCohort `json:"cohorts"`
+}
+
+type RetentionCohort struct {
+	Start   time.Time          `json:"start"`
+	Size    int                `json:"size"`
+	Periods []*RetentionPeriod `json:"periods"`
+}
+
+type RetentionPeriod struct {
+	Index int     `json:"index"`
+	Count int     `json:"count"`
+	Rate  float64 `json:"rate"`
+}
+
+type GetProjectPathsRequest struct {
+	Principal             Principal `json:"-" valid:"-"`
+	ProjectID             string    `json:"project_id" valid:"required"`
+	Event                 string    `json:"event" valid:"required~event is required"`
+	Direction             string    `json:"direction" valid:"required~direction is required,in(forward|backward)~invalid direction"`
+	Steps                 int       `json:"steps"`
+	SessionTimeoutMinutes int       `json:"session_timeout_minutes"`
+	CollapseRepeated      bool      `json:"collapse_repeated"`
+	Limit                 int       `json:"limit"`
+	From                  time.Time `json:"from" valid:"required~from is required"`
+	To                    time.Time `json:"to" valid:"required~to is required"`
+}
+
+type GetProjectPathsResponse struct {
+	Direction string      `json:"direction"`
+	Nodes     []*PathNode `json:"nodes"`
+	Edges     []*PathEdge `json:"edges"`
+	Paths     []*Path     `json:"paths"`
+}
+
+type PathNode struct {
+	ID    string `json:"id"`
+	Step  int    `json:"step"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type PathEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Count  int    `json:"count"`
+}
+
+type Path struct {
+	Events []string `json:"events"`
+	Count  int      `json:"count"`
 }
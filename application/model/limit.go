@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+const (
+	LimitKindRateLimit = "rate_limit"
+	LimitKindQuota     = "quota"
+)
+
+// LimitExceededError is returned when a rate limit or quota is exceeded, carrying what's needed to tell the client
+// when it can retry
+type LimitExceededError struct {
+	*AppError
+	Kind       string
+	Limit      int
+	ResetAt    time.Time
+	RetryAfter time.Duration
+}
+
+func NewLimitExceededError(
+	code string,
+	message string,
+	kind string,
+	limit int,
+	resetAt time.Time,
+	retryAfter time.Duration,
+) *LimitExceededError {
+	return &LimitExceededError{
+		AppError:   NewAppError(code, message, ErrorTypeTooManyRequests),
+		Kind:       kind,
+		Limit:      limit,
+		ResetAt:    resetAt,
+		RetryAfter: retryAfter,
+	}
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"io"
+	"time"
+)
+
 type CreateProjectRequest struct {
 	Name    string `json:"name" valid:"required"`
 	OwnerID string `json:"owner_id" valid:"required"`
@@ -9,18 +14,35 @@ type CreateProjectResponse struct {
 	ID      string `json:"id"`
 	Name    string `json:"name"`
 	OwnerID string `json:"owner_id"`
+	// IngestKey is the plain value of the default ingest api key, which can't be retrieved again
+	IngestApiKey *ProjectApiKey `json:"ingest_api_key"`
+	IngestKey    string         `json:"ingest_key"`
 }
 
 type EditProjectRequest struct {
+	ActorID                string    `json:"-" valid:"required~actor id is required"`
+	ProjectID              string    `json:"project_id" valid:"required"`
+	Name                   string    `json:"name" valid:"required"`
+	RequireSignedIngestion *bool     `json:"require_signed_ingestion" valid:"-"`
+	AllowedOrigins         *[]string `json:"allowed_origins" valid:"-"`
+}
+
+type EditProjectResponse struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	OwnerID                string   `json:"owner_id"`
+	RequireSignedIngestion bool     `json:"require_signed_ingestion"`
+	AllowedOrigins         []string `json:"allowed_origins"`
+}
+
+type RotateProjectSigningSecretRequest struct {
 	ActorID   string `json:"-" valid:"required~actor id is required"`
 	ProjectID string `json:"project_id" valid:"required"`
-	Name      string `json:"name" valid:"required"`
 }
 
-type EditProjectResponse struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	OwnerID string `json:"owner_id"`
+// RotateProjectSigningSecretResponse carries the new signing secret, which is only shown once
+type RotateProjectSigningSecretResponse struct {
+	SigningSecret string `json:"signing_secret"`
 }
 
 type ShowProjectRequest struct {
@@ -29,16 +51,19 @@ type ShowProjectRequest struct {
 }
 
 type ShowProjectResponse struct {
-	ID      string           `json:"id"`
-	Name    string           `json:"name"`
-	OwnerID string           `json:"owner_id"`
-	IsOwner bool             `json:"is_owner"`
-	Members []*ProjectMember `json:"members"`
+	ID                     string           `json:"id"`
+	Name                   string           `json:"name"`
+	OwnerID                string           `json:"owner_id"`
+	IsOwner                bool             `json:"is_owner"`
+	Role                   string           `json:"role"`
+	RequireSignedIngestion bool             `json:"require_signed_ingestion"`
+	AllowedOrigins         []string         `json:"allowed_origins"`
+	Members                []*ProjectMember `json:"members"`
 }
 
 type GetProjectStatsRequest struct {
-	ActorID   string `json:"-" valid:"required~actor id is required"`
-	ProjectID string `json:"project_id" valid:"required"`
+	Principal Principal `json:"-" valid:"-"`
+	ProjectID string    `json:"project_id" valid:"required"`
 }
 
 type GetProjectStatsResponse struct {
@@ -46,10 +71,70 @@ type GetProjectStatsResponse struct {
 	EventsCount  int `json:"events_count"`
 }
 
+type GetProjectEventsTimeSeriesRequest struct {
+	Principal   Principal `json:"-" valid:"-"`
+	ProjectID   string    `json:"project_id" valid:"required"`
+	Granularity string    `json:"granularity" valid:"required~granularity is required,in(minute|hour|day|week|month)~invalid granularity"`
+	From        time.Time `json:"from" valid:"required~from is required"`
+	To          time.Time `json:"to" valid:"required~to is required"`
+	Name        string    `json:"name"`
+	Timezone    string    `json:"timezone"`
+}
+
+type GetProjectEventsTimeSeriesResponse struct {
+	Granularity string                    `json:"granularity"`
+	Timezone    string                    `json:"timezone"`
+	Buckets     []*EventsTimeSeriesBucket `json:"buckets"`
+}
+
+type EventsTimeSeriesBucket struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+type ListProjectSessionsRequest struct {
+	Principal Principal `json:"-" valid:"-"`
+	ProjectID string    `json:"project_id" valid:"required"`
+	EndUserID string    `json:"end_user_id"`
+	From      time.Time `json:"from" valid:"required~from is required"`
+	To        time.Time `json:"to" valid:"required~to is required"`
+	Page      int       `json:"page"`
+	PageSize  int       `json:"page_size"`
+}
+
+type ListProjectSessionsResponse = []*ProjectSession
+
+type ProjectSession struct {
+	ID              string    `json:"id"`
+	EndUserID       string    `json:"end_user_id"`
+	ClientSessionID *string   `json:"client_session_id"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	EventCount      int       `json:"event_count"`
+	EntryEvent      string    `json:"entry_event"`
+	ExitEvent       string    `json:"exit_event"`
+}
+
+type GetProjectSessionStatsRequest struct {
+	Principal Principal `json:"-" valid:"-"`
+	ProjectID string    `json:"project_id" valid:"required"`
+	From      time.Time `json:"from" valid:"required~from is required"`
+	To        time.Time `json:"to" valid:"required~to is required"`
+}
+
+type GetProjectSessionStatsResponse struct {
+	SessionsCount          int     `json:"sessions_count"`
+	AverageDurationSeconds float64 `json:"average_duration_seconds"`
+	AverageEventCount      float64 `json:"average_event_count"`
+	BounceRate             float64 `json:"bounce_rate"`
+}
+
 type ProjectMember struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
+	Role  string `json:"role"`
 }
 
 type ListProjectByMemberResponse = []*ProjectByMember
@@ -68,16 +153,51 @@ type DeleteProjectRequest struct {
 type InviteProjectMembersRequest struct {
 	ActorID   string   `json:"-" valid:"required~actor id is required"`
 	ProjectID string   `json:"project_id" valid:"required"`
-	UserIDs   []string `json:"users" valid:"required"`
+	UserIDs   []string `json:"users" valid:"optional"`
+	// Emails may belong to people without an account, who are invited to register
+	Emails []string `json:"emails" valid:"optional"`
+	Role   string   `json:"role" valid:"optional,in(admin|editor|viewer)~invalid role"`
 }
 
 type InviteProjectMembersResponse = []*ProjectInvite
 
-type ProjectInvite struct {
-	ID      string         `json:"id"`
-	Project *InviteProject `json:"project"`
-	User    *InviteUser    `json:"user"`
+const (
+	BulkInviteRowStatusInvited        = "invited"
+	BulkInviteRowStatusAlreadyMember  = "already_member"
+	BulkInviteRowStatusAlreadyPending = "already_pending"
+	BulkInviteRowStatusInvalid        = "invalid"
+	// failed rows couldn't be checked because of an unexpected error, so they may be retried
+	BulkInviteRowStatusFailed = "failed"
+)
+
+// BulkInviteProjectMembersRequest carries a csv with an email and an optional role per row
+type BulkInviteProjectMembersRequest struct {
+	ActorID   string    `json:"-" valid:"required~actor id is required"`
+	ProjectID string    `json:"project_id" valid:"required"`
+	Csv       io.Reader `json:"-" valid:"-"`
+}
+
+type BulkInviteProjectMembersResponse struct {
+	Rows []*BulkInviteRow `json:"rows"`
+}
+
+type BulkInviteRow struct {
+	Line    int            `json:"line"`
+	Email   string         `json:"email"`
+	Role    string         `json:"role"`
 	Status  string         `json:"status"`
+	Message string         `json:"message,omitempty"`
+	Invite  *ProjectInvite `json:"invite,omitempty"`
+}
+
+type ProjectInvite struct {
+	ID        string         `json:"id"`
+	Project   *InviteProject `json:"project"`
+	User      *InviteUser    `json:"user"`
+	Email     string         `json:"email"`
+	Status    string         `json:"status"`
+	Role      string         `json:"role"`
+	ExpiresAt *time.Time     `json:"expires_at"`
 }
 
 type InviteProject struct {
@@ -101,6 +221,20 @@ type RevokeProjectInviteRequest struct {
 	ProjectInviteID string `json:"project_invite_id" valid:"required"`
 }
 
+type ResendProjectInviteRequest struct {
+	ActorID         string `json:"-" valid:"required~actor id is required"`
+	ProjectInviteID string `json:"project_invite_id" valid:"required"`
+}
+
+type SendProjectInviteRemindersRequest struct {
+	Now time.Time
+}
+
+type SendProjectInviteRemindersResponse struct {
+	ExpiredCount  int64
+	RemindedCount int
+}
+
 type RegisterEventRequest struct {
 	Name      string `json:"name" valid:"required"`
 	ProjectID string `json:"project_id" valid:"required"`
@@ -120,3 +254,163 @@ type ShowInvitationByProjectAndTokenUseCaseRequest struct {
 }
 
 type ShowInvitationByProjectAndTokenUseCaseResponse ProjectInvite
+
+type ProjectApiKey struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Scope         string     `json:"scope"`
+	Prefix        string     `json:"prefix"`
+	CreatedByID   string     `json:"created_by_id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	LastUsedAt    *time.Time `json:"last_used_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	AllowUnsigned bool       `json:"allow_unsigned"`
+}
+
+type CreateProjectApiKeyRequest struct {
+	ActorID       string `json:"-" valid:"required~actor id is required"`
+	ProjectID     string `json:"project_id" valid:"required"`
+	Name          string `json:"name" valid:"required~name is required"`
+	Scope         string `json:"scope" valid:"required~scope is required,in(ingest|read)~scope should be ingest or read"`
+	AllowUnsigned bool   `json:"allow_unsigned" valid:"-"`
+}
+
+// CreateProjectApiKeyResponse carries the plain api key, which can't be retrieved again
+type CreateProjectApiKeyResponse struct {
+	ApiKey *ProjectApiKey `json:"api_key"`
+	Key    string         `json:"key"`
+}
+
+type ListProjectApiKeysRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+}
+
+type ListProjectApiKeysResponse = []*ProjectApiKey
+
+type RotateProjectApiKeyRequest struct {
+	ActorID          string `json:"-" valid:"required~actor id is required"`
+	ProjectID        string `json:"project_id" valid:"required"`
+	ApiKeyID         string `json:"api_key_id" valid:"required"`
+	GracePeriodHours *int   `json:"grace_period_hours" valid:"-"`
+}
+
+type RotateProjectApiKeyResponse = CreateProjectApiKeyResponse
+
+type RevokeProjectApiKeyRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	ApiKeyID  string `json:"api_key_id" valid:"required"`
+}
+
+type AuthenticateProjectApiKeyRequest struct {
+	Key       string `json:"-" valid:"required~api key is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+}
+
+// AuthenticateProjectApiKeyResponse identifies requests made with a read api key as the key itself,
+// so its access doesn't follow the role of whoever created it
+type AuthenticateProjectApiKeyResponse struct {
+	Principal Principal `json:"-"`
+}
+
+type ChangeProjectMemberRoleRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	MemberID  string `json:"member_id" valid:"required"`
+	Role      string `json:"role" valid:"required~role is required,in(admin|editor|viewer)~invalid role"`
+}
+
+type ChangeProjectMemberRoleResponse struct {
+	ProjectID string `json:"project_id"`
+	MemberID  string `json:"member_id"`
+	Role      string `json:"role"`
+}
+
+type RemoveProjectMemberRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	MemberID  string `json:"member_id" valid:"required"`
+}
+
+type LeaveProjectRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+}
+
+type RequestProjectOwnershipTransferRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	NomineeID string `json:"nominee_id" valid:"required~nominee id is required"`
+}
+
+type ConfirmProjectOwnershipTransferRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	Token     string `json:"token" valid:"required~token is required"`
+}
+
+type ListProjectOwnershipTransfersRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+}
+
+type ListProjectOwnershipTransfersResponse = []*ProjectOwnershipTransfer
+
+type ProjectOwnershipTransfer struct {
+	ID          string     `json:"id"`
+	ProjectID   string     `json:"project_id"`
+	FromUserID  string     `json:"from_user_id"`
+	ToUserID    string     `json:"to_user_id"`
+	Status      string     `json:"status"`
+	RequestedAt time.Time  `json:"requested_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+}
+
+type ProjectInviteLink struct {
+	ID        string     `json:"id"`
+	ProjectID string     `json:"project_id"`
+	Token     string     `json:"token"`
+	Url       string     `json:"url"`
+	Role      string     `json:"role"`
+	MaxUses   *int       `json:"max_uses"`
+	UsesCount int        `json:"uses_count"`
+	Active    bool       `json:"active"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+type CreateProjectInviteLinkRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+	Role      string `json:"role" valid:"optional,in(admin|editor|viewer)~invalid role"`
+	MaxUses   *int   `json:"max_uses" valid:"-"`
+	// ExpiresAt defaults to a week from now
+	ExpiresAt *time.Time `json:"expires_at" valid:"-"`
+}
+
+type ListProjectInviteLinksRequest struct {
+	ActorID   string `json:"-" valid:"required~actor id is required"`
+	ProjectID string `json:"project_id" valid:"required"`
+}
+
+type ListProjectInviteLinksResponse = []*ProjectInviteLink
+
+type RevokeProjectInviteLinkRequest struct {
+	ActorID      string `json:"-" valid:"required~actor id is required"`
+	ProjectID    string `json:"project_id" valid:"required"`
+	InviteLinkID string `json:"invite_link_id" valid:"required"`
+}
+
+type JoinProjectByInviteLinkRequest struct {
+	ActorID string `json:"-" valid:"required~actor id is required"`
+	Token   string `json:"token" valid:"required~token is required"`
+}
+
+type JoinProjectByInviteLinkResponse struct {
+	ProjectID string `json:"project_id"`
+	Role      string `json:"role"`
+}
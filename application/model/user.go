@@ -21,11 +21,15 @@ type VerifyUserRequest struct {
 type SignInRequest struct {
 	Email    string `json:"email" valid:"email,required"`
 	Password string `json:"password" valid:"required"`
+	// UserAgent and IpAddress describe the device of the new session
+	UserAgent string `json:"-" valid:"-"`
+	IpAddress string `json:"-" valid:"-"`
 }
 
 type SignInResponse struct {
-	AccessToken string     `json:"access_token"`
-	User        SignInUser `json:"user"`
+	AccessToken  string     `json:"access_token"`
+	RefreshToken string     `json:"refresh_token"`
+	User         SignInUser `json:"user"`
 }
 
 type SignInUser struct {
@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// memoryStoreMaxBuckets bounds memory usage, buckets that refilled completely are the same as missing ones
+const memoryStoreMaxBuckets = 100_000
+
+type MemoryStore struct {
+	mutex   sync.Mutex
+	buckets map[string]*Bucket
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*Bucket{}}
+}
+
+func (store *MemoryStore) Take(key string, limit Limit, now time.Time) (*Result, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	bucket, ok := store.buckets[key]
+	if !ok {
+		if len(store.buckets) >= memoryStoreMaxBuckets {
+			store.pruneFullBuckets(limit, now)
+		}
+		bucket = NewFullBucket(limit, now)
+		store.buckets[key] = bucket
+	}
+	return bucket.Take(limit, now), nil
+}
+
+func (store *MemoryStore) pruneFullBuckets(limit Limit, now time.Time) {
+	for key, bucket := range store.buckets {
+		if bucket.Tokens+now.Sub(bucket.UpdatedAt).Seconds()*limit.Rate >= float64(limit.Burst) {
+			delete(store.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 2, Burst: 3}
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for remaining := 2; remaining >= 0; remaining-- {
+		result, err := store.Take("api_key", limit, now)
+		assert.Nil(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 3, result.Limit)
+		assert.Equal(t, remaining, result.Remaining)
+	}
+
+	result, err := store.Take("api_key", limit, now)
+	assert.Nil(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+
+	// other keys have their own buckets
+	result, _ = store.Take("ip", limit, now)
+	assert.True(t, result.Allowed)
+
+	result, _ = store.Take("api_key", limit, now.Add(500*time.Millisecond))
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// buckets don't refill past the burst
+	result, _ = store.Take("api_key", limit, now.Add(time.Hour))
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 2, result.Remaining)
+}
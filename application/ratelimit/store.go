@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Store keeps token buckets by key. The memory store works for a single instance,
+// deployments with multiple instances should use a shared store.
+type Store interface {
+	Take(key string, limit Limit, now time.Time) (*Result, error)
+}
+
+// Limit refills Rate tokens per second up to Burst tokens, each request taking one token
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Take refills the bucket for the time elapsed since its last update and takes a token when there's one available
+func (bucket *Bucket) Take(limit Limit, now time.Time) *Result {
+	elapsedSeconds := math.Max(0, now.Sub(bucket.UpdatedAt).Seconds())
+	bucket.Tokens = math.Min(float64(limit.Burst), bucket.Tokens+elapsedSeconds*limit.Rate)
+	bucket.UpdatedAt = now
+
+	result := &Result{Limit: limit.Burst}
+	if bucket.Tokens >= 1 {
+		bucket.Tokens--
+		result.Allowed = true
+	} else if limit.Rate > 0 {
+		result.RetryAfter = time.Duration((1 - bucket.Tokens) / limit.Rate * float64(time.Second))
+	}
+	result.Remaining = int(bucket.Tokens)
+	return result
+}
+
+func NewFullBucket(limit Limit, now time.Time) *Bucket {
+	return &Bucket{Tokens: float64(limit.Burst), UpdatedAt: now}
+}
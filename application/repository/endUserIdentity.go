@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+type EndUserIdentityRepository interface {
+	Register(*model.EndUserIdentity) error
+	FindByAnonymousID(projectID string, anonymousID string) (*model.EndUserIdentity, error)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: endUserIdentity.go
+//
+// Generated by this command:
+//
+//	mockgen --source endUserIdentity.go --package repository --destination endUserIdentity_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEndUserIdentityRepository is a mock of EndUserIdentityRepository interface.
+type MockEndUserIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndUserIdentityRepositoryMockRecorder
+}
+
+// MockEndUserIdentityRepositoryMockRecorder is the mock recorder for MockEndUserIdentityRepository.
+type MockEndUserIdentityRepositoryMockRecorder struct {
+	mock *MockEndUserIdentityRepository
+}
+
+// NewMockEndUserIdentityRepository creates a new mock instance.
+func NewMockEndUserIdentityRepository(ctrl *gomock.Controller) *MockEndUserIdentityRepository {
+	mock := &MockEndUserIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockEndUserIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndUserIdentityRepository) EXPECT() *MockEndUserIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByAnonymousID mocks base method.
+func (m *MockEndUserIdentityRepository) FindByAnonymousID(projectID, anonymousID string) (*model.EndUserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAnonymousID", projectID, anonymousID)
+	ret0, _ := ret[0].(*model.EndUserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAnonymousID indicates an expected call of FindByAnonymousID.
+func (mr *MockEndUserIdentityRepositoryMockRecorder) FindByAnonymousID(projectID, anonymousID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAnonymousID", reflect.TypeOf((*MockEndUserIdentityRepository)(nil).FindByAnonymousID), projectID, anonymousID)
+}
+
+// Register mocks base method.
+func (m *MockEndUserIdentityRepository) Register(arg0 *model.EndUserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockEndUserIdentityRepositoryMockRecorder) Register(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockEndUserIdentityRepository)(nil).Register), arg0)
+}
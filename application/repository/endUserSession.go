@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type EndUserSessionRepository interface {
+	BatchSave([]*model.EndUserSession) error
+	ListByClientSessionIDs(projectID string, clientSessionIDs []string) ([]*model.EndUserSession, error)
+	ListByEndUserIDs(projectID string, endUserIDs []string, from time.Time, to time.Time) ([]*model.EndUserSession, error)
+	List(options EndUserSessionListOptions) ([]*model.EndUserSession, error)
+	Aggregate(options EndUserSessionListOptions) (*EndUserSessionAggregate, error)
+	// RunLocked runs track with a repository holding a lock on each of the project session keys until it returns,
+	// so concurrent requests tracking the same sessions wait for each other instead of overwriting their changes
+	RunLocked(projectID string, sessionKeys []string, track func(EndUserSessionRepository) error) error
+}
+
+type EndUserSessionListOptions struct {
+	ProjectID string
+	EndUserID string
+	From      time.Time
+	To        time.Time
+	Page      int
+	PageSize  int
+}
+
+type EndUserSessionAggregate struct {
+	SessionsCount          int
+	BouncedSessionsCount   int
+	AverageDurationSeconds float64
+	AverageEventCount      float64
+}
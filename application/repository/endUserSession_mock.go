@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: endUserSession.go
+//
+// Generated by this command:
+//
+//	mockgen --source endUserSession.go --package repository --destination endUserSession_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEndUserSessionRepository is a mock of EndUserSessionRepository interface.
+type MockEndUserSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndUserSessionRepositoryMockRecorder
+}
+
+// MockEndUserSessionRepositoryMockRecorder is the mock recorder for MockEndUserSessionRepository.
+type MockEndUserSessionRepositoryMockRecorder struct {
+	mock *MockEndUserSessionRepository
+}
+
+// NewMockEndUserSessionRepository creates a new mock instance.
+func NewMockEndUserSessionRepository(ctrl *gomock.Controller) *MockEndUserSessionRepository {
+	mock := &MockEndUserSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockEndUserSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndUserSessionRepository) EXPECT() *MockEndUserSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Aggregate mocks base method.
+func (m *MockEndUserSessionRepository) Aggregate(options EndUserSessionListOptions) (*EndUserSessionAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", options)
+	ret0, _ := ret[0].(*EndUserSessionAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockEndUserSessionRepositoryMockRecorder) Aggregate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockEndUserSessionRepository)(nil).Aggregate), options)
+}
+
+// BatchSave mocks base method.
+func (m *MockEndUserSessionRepository) BatchSave(arg0 []*model.EndUserSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSave", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSave indicates an expected call of BatchSave.
+func (mr *MockEndUserSessionRepositoryMockRecorder) BatchSave(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSave", reflect.TypeOf((*MockEndUserSessionRepository)(nil).BatchSave), arg0)
+}
+
+// List mocks base method.
+func (m *MockEndUserSessionRepository) List(options EndUserSessionListOptions) ([]*model.EndUserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", options)
+	ret0, _ := ret[0].([]*model.EndUserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEndUserSessionRepositoryMockRecorder) List(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEndUserSessionRepository)(nil).List), options)
+}
+
+// ListByClientSessionIDs mocks base method.
+func (m *MockEndUserSessionRepository) ListByClientSessionIDs(projectID string, clientSessionIDs []string) ([]*model.EndUserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByClientSessionIDs", projectID, clientSessionIDs)
+	ret0, _ := ret[0].([]*model.EndUserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByClientSessionIDs indicates an expected call of ListByClientSessionIDs.
+func (mr *MockEndUserSessionRepositoryMockRecorder) ListByClientSessionIDs(projectID, clientSessionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByClientSessionIDs", reflect.TypeOf((*MockEndUserSessionRepository)(nil).ListByClientSessionIDs), projectID, clientSessionIDs)
+}
+
+// ListByEndUserIDs mocks base method.
+func (m *MockEndUserSessionRepository) ListByEndUserIDs(projectID string, endUserIDs []string, from, to time.Time) ([]*model.EndUserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEndUserIDs", projectID, endUserIDs, from, to)
+	ret0, _ := ret[0].([]*model.EndUserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEndUserIDs indicates an expected call of ListByEndUserIDs.
+func (mr *MockEndUserSessionRepositoryMockRecorder) ListByEndUserIDs(projectID, endUserIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEndUserIDs", reflect.TypeOf((*MockEndUserSessionRepository)(nil).ListByEndUserIDs), projectID, endUserIDs, from, to)
+}
+
+// RunLocked mocks base method.
+func (m *MockEndUserSessionRepository) RunLocked(projectID string, sessionKeys []string, track func(EndUserSessionRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunLocked", projectID, sessionKeys, track)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunLocked indicates an expected call of RunLocked.
+func (mr *MockEndUserSessionRepositoryMockRecorder) RunLocked(projectID, sessionKeys, track any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLocked", reflect.TypeOf((*MockEndUserSessionRepository)(nil).RunLocked), projectID, sessionKeys, track)
+}
@@ -1,7 +1,83 @@
 package repository
 
-import "github.com/RuanScherer/journey-track-api/domain/model"
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+const (
+	EventListOrderAsc  = "asc"
+	EventListOrderDesc = "desc"
+)
 
 type EventRepository interface {
-	Register(*model.Event) error
+	// Register tells whether the event was registered, retries of an event whose client event ID is stored aren't
+	Register(*model.Event) (bool, error)
+	// BatchRegister returns the events that were registered, leaving out retries like Register
+	BatchRegister([]*model.Event) ([]*model.Event, error)
+	ReleaseClientEventIDs(projectID string, clientEventIDs []string, receivedBefore time.Time) error
+	List(options EventListOptions) ([]*model.Event, error)
+	CountByInterval(options EventCountByIntervalOptions) ([]*EventIntervalCount, error)
+	ListOccurrences(options EventOccurrenceOptions) ([]*EventOccurrence, error)
+	ListFirstOccurrences(options EventFirstOccurrenceOptions) ([]*EventOccurrence, error)
+}
+
+type EventListOptions struct {
+	ProjectID  string
+	Name       string
+	From       *time.Time
+	To         *time.Time
+	Properties map[string]string
+	Order      string
+	After      *EventCursor
+	Limit      int
+}
+
+type EventCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+type EventCountByIntervalOptions struct {
+	ProjectID string
+	Name      string
+	From      time.Time
+	To        time.Time
+	Interval  string
+	Timezone  string
+}
+
+type EventIntervalCount struct {
+	// wall clock time of the interval start in the requested timezone
+	IntervalStart time.Time
+	Count         int
+}
+
+type EventOccurrenceOptions struct {
+	ProjectID string
+	Names     []string
+	From      time.Time
+	To        time.Time
+	// occurrences are sorted by actor, timestamp and event ID, so pages continue after the last one read
+	After *EventOccurrence
+	Limit int
+}
+
+// EventFirstOccurrenceOptions looks up the first time each end user performed an event, keeping only the end
+// users whose first time falls between From and To
+type EventFirstOccurrenceOptions struct {
+	ProjectID string
+	Name      string
+	From      time.Time
+	To        time.Time
+}
+
+// EventOccurrence is an event attributed to the end user who performed it
+type EventOccurrence struct {
+	EventID    string
+	ActorID    string
+	Name       string
+	Timestamp  time.Time
+	Properties model.EventProperties
 }
@@ -11,6 +11,7 @@ package repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	model "github.com/RuanScherer/journey-track-api/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -39,12 +40,88 @@ func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchRegister mocks base method.
+func (m *MockEventRepository) BatchRegister(arg0 []*model.Event) ([]*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchRegister", arg0)
+	ret0, _ := ret[0].([]*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchRegister indicates an expected call of BatchRegister.
+func (mr *MockEventRepositoryMockRecorder) BatchRegister(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchRegister", reflect.TypeOf((*MockEventRepository)(nil).BatchRegister), arg0)
+}
+
+// CountByInterval mocks base method.
+func (m *MockEventRepository) CountByInterval(options EventCountByIntervalOptions) ([]*EventIntervalCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByInterval", options)
+	ret0, _ := ret[0].([]*EventIntervalCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByInterval indicates an expected call of CountByInterval.
+func (mr *MockEventRepositoryMockRecorder) CountByInterval(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByInterval", reflect.TypeOf((*MockEventRepository)(nil).CountByInterval), options)
+}
+
+// List mocks base method.
+func (m *MockEventRepository) List(options EventListOptions) ([]*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", options)
+	ret0, _ := ret[0].([]*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEventRepositoryMockRecorder) List(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEventRepository)(nil).List), options)
+}
+
+// ListFirstOccurrences mocks base method.
+func (m *MockEventRepository) ListFirstOccurrences(options EventFirstOccurrenceOptions) ([]*EventOccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirstOccurrences", options)
+	ret0, _ := ret[0].([]*EventOccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirstOccurrences indicates an expected call of ListFirstOccurrences.
+func (mr *MockEventRepositoryMockRecorder) ListFirstOccurrences(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirstOccurrences", reflect.TypeOf((*MockEventRepository)(nil).ListFirstOccurrences), options)
+}
+
+// ListOccurrences mocks base method.
+func (m *MockEventRepository) ListOccurrences(options EventOccurrenceOptions) ([]*EventOccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOccurrences", options)
+	ret0, _ := ret[0].([]*EventOccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOccurrences indicates an expected call of ListOccurrences.
+func (mr *MockEventRepositoryMockRecorder) ListOccurrences(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOccurrences", reflect.TypeOf((*MockEventRepository)(nil).ListOccurrences), options)
+}
+
 // Register mocks base method.
-func (m *MockEventRepository) Register(arg0 *model.Event) error {
+func (m *MockEventRepository) Register(arg0 *model.Event) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Register", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Register indicates an expected call of Register.
@@ -52,3 +129,17 @@ func (mr *MockEventRepositoryMockRecorder) Register(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockEventRepository)(nil).Register), arg0)
 }
+
+// ReleaseClientEventIDs mocks base method.
+func (m *MockEventRepository) ReleaseClientEventIDs(projectID string, clientEventIDs []string, receivedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseClientEventIDs", projectID, clientEventIDs, receivedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseClientEventIDs indicates an expected call of ReleaseClientEventIDs.
+func (mr *MockEventRepositoryMockRecorder) ReleaseClientEventIDs(projectID, clientEventIDs, receivedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseClientEventIDs", reflect.TypeOf((*MockEventRepository)(nil).ReleaseClientEventIDs), projectID, clientEventIDs, receivedBefore)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type IngestionSignatureRepository interface {
+	// Register fails with gorm.ErrDuplicatedKey when the signature was already used in the project
+	Register(*model.IngestionSignature) error
+	DeleteSignedBefore(projectID string, before time.Time) error
+}
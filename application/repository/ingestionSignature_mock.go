@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ingestionSignature.go
+//
+// Generated by this command:
+//
+//	mockgen --source ingestionSignature.go --package repository --destination ingestionSignature_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIngestionSignatureRepository is a mock of IngestionSignatureRepository interface.
+type MockIngestionSignatureRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIngestionSignatureRepositoryMockRecorder
+}
+
+// MockIngestionSignatureRepositoryMockRecorder is the mock recorder for MockIngestionSignatureRepository.
+type MockIngestionSignatureRepositoryMockRecorder struct {
+	mock *MockIngestionSignatureRepository
+}
+
+// NewMockIngestionSignatureRepository creates a new mock instance.
+func NewMockIngestionSignatureRepository(ctrl *gomock.Controller) *MockIngestionSignatureRepository {
+	mock := &MockIngestionSignatureRepository{ctrl: ctrl}
+	mock.recorder = &MockIngestionSignatureRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIngestionSignatureRepository) EXPECT() *MockIngestionSignatureRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteSignedBefore mocks base method.
+func (m *MockIngestionSignatureRepository) DeleteSignedBefore(projectID string, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSignedBefore", projectID, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSignedBefore indicates an expected call of DeleteSignedBefore.
+func (mr *MockIngestionSignatureRepositoryMockRecorder) DeleteSignedBefore(projectID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSignedBefore", reflect.TypeOf((*MockIngestionSignatureRepository)(nil).DeleteSignedBefore), projectID, before)
+}
+
+// Register mocks base method.
+func (m *MockIngestionSignatureRepository) Register(arg0 *model.IngestionSignature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIngestionSignatureRepositoryMockRecorder) Register(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIngestionSignatureRepository)(nil).Register), arg0)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(token *model.PersonalAccessToken) error
+	Save(token *model.PersonalAccessToken) error
+	FindById(tokenId string) (*model.PersonalAccessToken, error)
+	FindByTokenHash(tokenHash string) (*model.PersonalAccessToken, error)
+	ListActiveByUser(userId string, now time.Time) ([]*model.PersonalAccessToken, error)
+	UpdateLastUsedAt(tokenId string, lastUsedAt time.Time) error
+	RevokeAllByUser(userId string, now time.Time) error
+}
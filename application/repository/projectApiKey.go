@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type ProjectApiKeyRepository interface {
+	Register(*model.ProjectApiKey) error
+	Save(*model.ProjectApiKey) error
+	FindById(id string) (*model.ProjectApiKey, error)
+	FindByKeyHash(keyHash string) (*model.ProjectApiKey, error)
+	FindByProjectId(projectID string) ([]*model.ProjectApiKey, error)
+	UpdateLastUsedAt(id string, lastUsedAt time.Time) error
+}
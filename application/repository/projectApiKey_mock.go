@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projectApiKey.go
+//
+// Generated by this command:
+//
+//	mockgen --source projectApiKey.go --package repository --destination projectApiKey_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectApiKeyRepository is a mock of ProjectApiKeyRepository interface.
+type MockProjectApiKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectApiKeyRepositoryMockRecorder
+}
+
+// MockProjectApiKeyRepositoryMockRecorder is the mock recorder for MockProjectApiKeyRepository.
+type MockProjectApiKeyRepositoryMockRecorder struct {
+	mock *MockProjectApiKeyRepository
+}
+
+// NewMockProjectApiKeyRepository creates a new mock instance.
+func NewMockProjectApiKeyRepository(ctrl *gomock.Controller) *MockProjectApiKeyRepository {
+	mock := &MockProjectApiKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectApiKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectApiKeyRepository) EXPECT() *MockProjectApiKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockProjectApiKeyRepository) FindById(id string) (*model.ProjectApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", id)
+	ret0, _ := ret[0].(*model.ProjectApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) FindById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).FindById), id)
+}
+
+// FindByKeyHash mocks base method.
+func (m *MockProjectApiKeyRepository) FindByKeyHash(keyHash string) (*model.ProjectApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKeyHash", keyHash)
+	ret0, _ := ret[0].(*model.ProjectApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKeyHash indicates an expected call of FindByKeyHash.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) FindByKeyHash(keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKeyHash", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).FindByKeyHash), keyHash)
+}
+
+// FindByProjectId mocks base method.
+func (m *MockProjectApiKeyRepository) FindByProjectId(projectID string) ([]*model.ProjectApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectId", projectID)
+	ret0, _ := ret[0].([]*model.ProjectApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectId indicates an expected call of FindByProjectId.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) FindByProjectId(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectId", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).FindByProjectId), projectID)
+}
+
+// Register mocks base method.
+func (m *MockProjectApiKeyRepository) Register(arg0 *model.ProjectApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) Register(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).Register), arg0)
+}
+
+// Save mocks base method.
+func (m *MockProjectApiKeyRepository) Save(arg0 *model.ProjectApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) Save(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).Save), arg0)
+}
+
+// UpdateLastUsedAt mocks base method.
+func (m *MockProjectApiKeyRepository) UpdateLastUsedAt(id string, lastUsedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsedAt", id, lastUsedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsedAt indicates an expected call of UpdateLastUsedAt.
+func (mr *MockProjectApiKeyRepositoryMockRecorder) UpdateLastUsedAt(id, lastUsedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsedAt", reflect.TypeOf((*MockProjectApiKeyRepository)(nil).UpdateLastUsedAt), id, lastUsedAt)
+}
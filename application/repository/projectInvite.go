@@ -1,14 +1,26 @@
 package repository
 
-import "github.com/RuanScherer/journey-track-api/domain/model"
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
 
 type ProjectInviteRepository interface {
 	Create(projectInvite *model.ProjectInvite) error
 	BatchCreate(projectInvites []*model.ProjectInvite) error
 	Save(projectInvite *model.ProjectInvite) error
 	DeleteById(projectInviteId string) error
+	DeletePendingByProjectAndInviter(projectId string, inviterId string) error
 	FindById(projectInviteId string) (*model.ProjectInvite, error)
 	ListByProjectAndStatus(projectId string, status string) ([]*model.ProjectInvite, error)
 	FindByProjectAndToken(projectId string, token string) (*model.ProjectInvite, error)
 	FindPendingByUserAndProject(userId string, projectId string) (*model.ProjectInvite, error)
+	FindPendingByEmailAndProject(email string, projectId string) (*model.ProjectInvite, error)
+	// ListPendingUnattachedByEmail lists invites sent to the email before anyone registered with it
+	ListPendingUnattachedByEmail(email string) ([]*model.ProjectInvite, error)
+	ExpireOverdue(now time.Time) (int64, error)
+	ListPendingToRemind(now time.Time, expiringBefore time.Time) ([]*model.ProjectInvite, error)
+	// ClaimReminder marks the reminder as sent and reports false when another run already claimed it
+	ClaimReminder(projectInviteId string, now time.Time) (bool, error)
 }
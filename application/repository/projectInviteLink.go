@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+type ProjectInviteLinkRepository interface {
+	Create(link *model.ProjectInviteLink) error
+	Save(link *model.ProjectInviteLink) error
+	FindById(linkId string) (*model.ProjectInviteLink, error)
+	FindByToken(token string) (*model.ProjectInviteLink, error)
+	ListByProject(projectId string) ([]*model.ProjectInviteLink, error)
+	// Join counts the use, adds the member and records the join at once, failing with gorm.ErrRecordNotFound
+	// when the link stopped being usable in the meantime
+	Join(link *model.ProjectInviteLink, join *model.ProjectInviteLinkJoin) error
+}
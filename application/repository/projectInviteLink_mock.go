@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projectInviteLink.go
+//
+// Generated by this command:
+//
+//	mockgen --source projectInviteLink.go --package repository --destination projectInviteLink_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectInviteLinkRepository is a mock of ProjectInviteLinkRepository interface.
+type MockProjectInviteLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectInviteLinkRepositoryMockRecorder
+}
+
+// MockProjectInviteLinkRepositoryMockRecorder is the mock recorder for MockProjectInviteLinkRepository.
+type MockProjectInviteLinkRepositoryMockRecorder struct {
+	mock *MockProjectInviteLinkRepository
+}
+
+// NewMockProjectInviteLinkRepository creates a new mock instance.
+func NewMockProjectInviteLinkRepository(ctrl *gomock.Controller) *MockProjectInviteLinkRepository {
+	mock := &MockProjectInviteLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectInviteLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectInviteLinkRepository) EXPECT() *MockProjectInviteLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProjectInviteLinkRepository) Create(link *model.ProjectInviteLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) Create(link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).Create), link)
+}
+
+// FindById mocks base method.
+func (m *MockProjectInviteLinkRepository) FindById(linkId string) (*model.ProjectInviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", linkId)
+	ret0, _ := ret[0].(*model.ProjectInviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) FindById(linkId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).FindById), linkId)
+}
+
+// FindByToken mocks base method.
+func (m *MockProjectInviteLinkRepository) FindByToken(token string) (*model.ProjectInviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByToken", token)
+	ret0, _ := ret[0].(*model.ProjectInviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByToken indicates an expected call of FindByToken.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) FindByToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByToken", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).FindByToken), token)
+}
+
+// Join mocks base method.
+func (m *MockProjectInviteLinkRepository) Join(link *model.ProjectInviteLink, join *model.ProjectInviteLinkJoin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", link, join)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) Join(link, join any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).Join), link, join)
+}
+
+// ListByProject mocks base method.
+func (m *MockProjectInviteLinkRepository) ListByProject(projectId string) ([]*model.ProjectInviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProject", projectId)
+	ret0, _ := ret[0].([]*model.ProjectInviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProject indicates an expected call of ListByProject.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) ListByProject(projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProject", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).ListByProject), projectId)
+}
+
+// Save mocks base method.
+func (m *MockProjectInviteLinkRepository) Save(link *model.ProjectInviteLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockProjectInviteLinkRepositoryMockRecorder) Save(link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProjectInviteLinkRepository)(nil).Save), link)
+}
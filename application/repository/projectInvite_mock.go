@@ -11,6 +11,7 @@ package repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	model "github.com/RuanScherer/journey-track-api/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -53,6 +54,21 @@ func (mr *MockProjectInviteRepositoryMockRecorder) BatchCreate(projectInvites an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockProjectInviteRepository)(nil).BatchCreate), projectInvites)
 }
 
+// ClaimReminder mocks base method.
+func (m *MockProjectInviteRepository) ClaimReminder(projectInviteId string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimReminder", projectInviteId, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimReminder indicates an expected call of ClaimReminder.
+func (mr *MockProjectInviteRepositoryMockRecorder) ClaimReminder(projectInviteId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimReminder", reflect.TypeOf((*MockProjectInviteRepository)(nil).ClaimReminder), projectInviteId, now)
+}
+
 // Create mocks base method.
 func (m *MockProjectInviteRepository) Create(projectInvite *model.ProjectInvite) error {
 	m.ctrl.T.Helper()
@@ -81,6 +97,35 @@ func (mr *MockProjectInviteRepositoryMockRecorder) DeleteById(projectInviteId an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockProjectInviteRepository)(nil).DeleteById), projectInviteId)
 }
 
+// DeletePendingByProjectAndInviter mocks base method.
+func (m *MockProjectInviteRepository) DeletePendingByProjectAndInviter(projectId, inviterId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingByProjectAndInviter", projectId, inviterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingByProjectAndInviter indicates an expected call of DeletePendingByProjectAndInviter.
+func (mr *MockProjectInviteRepositoryMockRecorder) DeletePendingByProjectAndInviter(projectId, inviterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingByProjectAndInviter", reflect.TypeOf((*MockProjectInviteRepository)(nil).DeletePendingByProjectAndInviter), projectId, inviterId)
+}
+
+// ExpireOverdue mocks base method.
+func (m *MockProjectInviteRepository) ExpireOverdue(now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOverdue", now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOverdue indicates an expected call of ExpireOverdue.
+func (mr *MockProjectInviteRepositoryMockRecorder) ExpireOverdue(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOverdue", reflect.TypeOf((*MockProjectInviteRepository)(nil).ExpireOverdue), now)
+}
+
 // FindById mocks base method.
 func (m *MockProjectInviteRepository) FindById(projectInviteId string) (*model.ProjectInvite, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +156,21 @@ func (mr *MockProjectInviteRepositoryMockRecorder) FindByProjectAndToken(project
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectAndToken", reflect.TypeOf((*MockProjectInviteRepository)(nil).FindByProjectAndToken), projectId, token)
 }
 
+// FindPendingByEmailAndProject mocks base method.
+func (m *MockProjectInviteRepository) FindPendingByEmailAndProject(email, projectId string) (*model.ProjectInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByEmailAndProject", email, projectId)
+	ret0, _ := ret[0].(*model.ProjectInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByEmailAndProject indicates an expected call of FindPendingByEmailAndProject.
+func (mr *MockProjectInviteRepositoryMockRecorder) FindPendingByEmailAndProject(email, projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByEmailAndProject", reflect.TypeOf((*MockProjectInviteRepository)(nil).FindPendingByEmailAndProject), email, projectId)
+}
+
 // FindPendingByUserAndProject mocks base method.
 func (m *MockProjectInviteRepository) FindPendingByUserAndProject(userId, projectId string) (*model.ProjectInvite, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +201,36 @@ func (mr *MockProjectInviteRepositoryMockRecorder) ListByProjectAndStatus(projec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProjectAndStatus", reflect.TypeOf((*MockProjectInviteRepository)(nil).ListByProjectAndStatus), projectId, status)
 }
 
+// ListPendingToRemind mocks base method.
+func (m *MockProjectInviteRepository) ListPendingToRemind(now, expiringBefore time.Time) ([]*model.ProjectInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingToRemind", now, expiringBefore)
+	ret0, _ := ret[0].([]*model.ProjectInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingToRemind indicates an expected call of ListPendingToRemind.
+func (mr *MockProjectInviteRepositoryMockRecorder) ListPendingToRemind(now, expiringBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingToRemind", reflect.TypeOf((*MockProjectInviteRepository)(nil).ListPendingToRemind), now, expiringBefore)
+}
+
+// ListPendingUnattachedByEmail mocks base method.
+func (m *MockProjectInviteRepository) ListPendingUnattachedByEmail(email string) ([]*model.ProjectInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingUnattachedByEmail", email)
+	ret0, _ := ret[0].([]*model.ProjectInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingUnattachedByEmail indicates an expected call of ListPendingUnattachedByEmail.
+func (mr *MockProjectInviteRepositoryMockRecorder) ListPendingUnattachedByEmail(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingUnattachedByEmail", reflect.TypeOf((*MockProjectInviteRepository)(nil).ListPendingUnattachedByEmail), email)
+}
+
 // Save mocks base method.
 func (m *MockProjectInviteRepository) Save(projectInvite *model.ProjectInvite) error {
 	m.ctrl.T.Helper()
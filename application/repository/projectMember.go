@@ -0,0 +1,9 @@
+package repository
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+type ProjectMemberRepository interface {
+	FindByProjectAndUser(projectID, userID string) (*model.ProjectMember, error)
+	Save(member *model.ProjectMember) error
+	Delete(member *model.ProjectMember) error
+}
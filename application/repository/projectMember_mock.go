@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projectMember.go
+//
+// Generated by this command:
+//
+//	mockgen --source projectMember.go --package repository --destination projectMember_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectMemberRepository is a mock of ProjectMemberRepository interface.
+type MockProjectMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectMemberRepositoryMockRecorder
+}
+
+// MockProjectMemberRepositoryMockRecorder is the mock recorder for MockProjectMemberRepository.
+type MockProjectMemberRepositoryMockRecorder struct {
+	mock *MockProjectMemberRepository
+}
+
+// NewMockProjectMemberRepository creates a new mock instance.
+func NewMockProjectMemberRepository(ctrl *gomock.Controller) *MockProjectMemberRepository {
+	mock := &MockProjectMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectMemberRepository) EXPECT() *MockProjectMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockProjectMemberRepository) Delete(member *model.ProjectMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectMemberRepositoryMockRecorder) Delete(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectMemberRepository)(nil).Delete), member)
+}
+
+// FindByProjectAndUser mocks base method.
+func (m *MockProjectMemberRepository) FindByProjectAndUser(projectID, userID string) (*model.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectAndUser", projectID, userID)
+	ret0, _ := ret[0].(*model.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectAndUser indicates an expected call of FindByProjectAndUser.
+func (mr *MockProjectMemberRepositoryMockRecorder) FindByProjectAndUser(projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectAndUser", reflect.TypeOf((*MockProjectMemberRepository)(nil).FindByProjectAndUser), projectID, userID)
+}
+
+// Save mocks base method.
+func (m *MockProjectMemberRepository) Save(member *model.ProjectMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockProjectMemberRepositoryMockRecorder) Save(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProjectMemberRepository)(nil).Save), member)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+type ProjectOwnershipTransferRepository interface {
+	Create(transfer *model.ProjectOwnershipTransfer) error
+	Save(transfer *model.ProjectOwnershipTransfer) error
+	FindById(transferId string) (*model.ProjectOwnershipTransfer, error)
+	FindByProjectAndToken(projectId string, token string) (*model.ProjectOwnershipTransfer, error)
+	FindPendingByProject(projectId string) (*model.ProjectOwnershipTransfer, error)
+	ListByProject(projectId string) ([]*model.ProjectOwnershipTransfer, error)
+	// Confirm stores the confirmed transfer along with the new project owner and member roles at once
+	Confirm(transfer *model.ProjectOwnershipTransfer, project *model.Project) error
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projectOwnershipTransfer.go
+//
+// Generated by this command:
+//
+//	mockgen --source projectOwnershipTransfer.go --package repository --destination projectOwnershipTransfer_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectOwnershipTransferRepository is a mock of ProjectOwnershipTransferRepository interface.
+type MockProjectOwnershipTransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectOwnershipTransferRepositoryMockRecorder
+}
+
+// MockProjectOwnershipTransferRepositoryMockRecorder is the mock recorder for MockProjectOwnershipTransferRepository.
+type MockProjectOwnershipTransferRepositoryMockRecorder struct {
+	mock *MockProjectOwnershipTransferRepository
+}
+
+// NewMockProjectOwnershipTransferRepository creates a new mock instance.
+func NewMockProjectOwnershipTransferRepository(ctrl *gomock.Controller) *MockProjectOwnershipTransferRepository {
+	mock := &MockProjectOwnershipTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectOwnershipTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectOwnershipTransferRepository) EXPECT() *MockProjectOwnershipTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockProjectOwnershipTransferRepository) Confirm(transfer *model.ProjectOwnershipTransfer, project *model.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", transfer, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) Confirm(transfer, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).Confirm), transfer, project)
+}
+
+// Create mocks base method.
+func (m *MockProjectOwnershipTransferRepository) Create(transfer *model.ProjectOwnershipTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) Create(transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).Create), transfer)
+}
+
+// FindById mocks base method.
+func (m *MockProjectOwnershipTransferRepository) FindById(transferId string) (*model.ProjectOwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", transferId)
+	ret0, _ := ret[0].(*model.ProjectOwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) FindById(transferId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).FindById), transferId)
+}
+
+// FindByProjectAndToken mocks base method.
+func (m *MockProjectOwnershipTransferRepository) FindByProjectAndToken(projectId, token string) (*model.ProjectOwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectAndToken", projectId, token)
+	ret0, _ := ret[0].(*model.ProjectOwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectAndToken indicates an expected call of FindByProjectAndToken.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) FindByProjectAndToken(projectId, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectAndToken", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).FindByProjectAndToken), projectId, token)
+}
+
+// FindPendingByProject mocks base method.
+func (m *MockProjectOwnershipTransferRepository) FindPendingByProject(projectId string) (*model.ProjectOwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByProject", projectId)
+	ret0, _ := ret[0].(*model.ProjectOwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByProject indicates an expected call of FindPendingByProject.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) FindPendingByProject(projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByProject", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).FindPendingByProject), projectId)
+}
+
+// ListByProject mocks base method.
+func (m *MockProjectOwnershipTransferRepository) ListByProject(projectId string) ([]*model.ProjectOwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProject", projectId)
+	ret0, _ := ret[0].([]*model.ProjectOwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProject indicates an expected call of ListByProject.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) ListByProject(projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProject", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).ListByProject), projectId)
+}
+
+// Save mocks base method.
+func (m *MockProjectOwnershipTransferRepository) Save(transfer *model.ProjectOwnershipTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockProjectOwnershipTransferRepositoryMockRecorder) Save(transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProjectOwnershipTransferRepository)(nil).Save), transfer)
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "time"
+
+type ProjectUsageRepository interface {
+	IncrementEventCount(projectID string, periodStart time.Time, count int) error
+	// ReserveEventCount increments the event count only when it stays within the quota, telling whether it did
+	ReserveEventCount(projectID string, periodStart time.Time, count int, quota int) (bool, error)
+	ReleaseEventCount(projectID string, periodStart time.Time, count int) error
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projectUsage.go
+//
+// Generated by this command:
+//
+//	mockgen --source projectUsage.go --package repository --destination projectUsage_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectUsageRepository is a mock of ProjectUsageRepository interface.
+type MockProjectUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectUsageRepositoryMockRecorder
+}
+
+// MockProjectUsageRepositoryMockRecorder is the mock recorder for MockProjectUsageRepository.
+type MockProjectUsageRepositoryMockRecorder struct {
+	mock *MockProjectUsageRepository
+}
+
+// NewMockProjectUsageRepository creates a new mock instance.
+func NewMockProjectUsageRepository(ctrl *gomock.Controller) *MockProjectUsageRepository {
+	mock := &MockProjectUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectUsageRepository) EXPECT() *MockProjectUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// IncrementEventCount mocks base method.
+func (m *MockProjectUsageRepository) IncrementEventCount(projectID string, periodStart time.Time, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementEventCount", projectID, periodStart, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementEventCount indicates an expected call of IncrementEventCount.
+func (mr *MockProjectUsageRepositoryMockRecorder) IncrementEventCount(projectID, periodStart, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementEventCount", reflect.TypeOf((*MockProjectUsageRepository)(nil).IncrementEventCount), projectID, periodStart, count)
+}
+
+// ReleaseEventCount mocks base method.
+func (m *MockProjectUsageRepository) ReleaseEventCount(projectID string, periodStart time.Time, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEventCount", projectID, periodStart, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseEventCount indicates an expected call of ReleaseEventCount.
+func (mr *MockProjectUsageRepositoryMockRecorder) ReleaseEventCount(projectID, periodStart, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEventCount", reflect.TypeOf((*MockProjectUsageRepository)(nil).ReleaseEventCount), projectID, periodStart, count)
+}
+
+// ReserveEventCount mocks base method.
+func (m *MockProjectUsageRepository) ReserveEventCount(projectID string, periodStart time.Time, count, quota int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveEventCount", projectID, periodStart, count, quota)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveEventCount indicates an expected call of ReserveEventCount.
+func (mr *MockProjectUsageRepositoryMockRecorder) ReserveEventCount(projectID, periodStart, count, quota any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveEventCount", reflect.TypeOf((*MockProjectUsageRepository)(nil).ReserveEventCount), projectID, periodStart, count, quota)
+}
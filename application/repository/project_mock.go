@@ -83,21 +83,6 @@ func (mr *MockProjectRepositoryMockRecorder) FindByMemberId(memberId any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMemberId", reflect.TypeOf((*MockProjectRepository)(nil).FindByMemberId), memberId)
 }
 
-// FindByToken mocks base method.
-func (m *MockProjectRepository) FindByToken(token string) (*model.Project, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByToken", token)
-	ret0, _ := ret[0].(*model.Project)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindByToken indicates an expected call of FindByToken.
-func (mr *MockProjectRepositoryMockRecorder) FindByToken(token any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByToken", reflect.TypeOf((*MockProjectRepository)(nil).FindByToken), token)
-}
-
 // FindMembersCountAndEventsCountById mocks base method.
 func (m *MockProjectRepository) FindMembersCountAndEventsCountById(id string) (*ProjectInvitesCountAndEventsCount, error) {
 	m.ctrl.T.Helper()
@@ -113,21 +98,6 @@ func (mr *MockProjectRepositoryMockRecorder) FindMembersCountAndEventsCountById(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembersCountAndEventsCountById", reflect.TypeOf((*MockProjectRepository)(nil).FindMembersCountAndEventsCountById), id)
 }
 
-// HasMember mocks base method.
-func (m *MockProjectRepository) HasMember(projectID, memberID string) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasMember", projectID, memberID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// HasMember indicates an expected call of HasMember.
-func (mr *MockProjectRepositoryMockRecorder) HasMember(projectID, memberID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMember", reflect.TypeOf((*MockProjectRepository)(nil).HasMember), projectID, memberID)
-}
-
 // Register mocks base method.
 func (m *MockProjectRepository) Register(project *model.Project) error {
 	m.ctrl.T.Helper()
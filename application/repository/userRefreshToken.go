@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/RuanScherer/journey-track-api/domain/model"
+
+type UserRefreshTokenRepository interface {
+	Create(refreshToken *model.UserRefreshToken) error
+	FindByTokenHash(tokenHash string) (*model.UserRefreshToken, error)
+	// Rotate stores the used token and its replacement, failing with gorm.ErrRecordNotFound
+	// when the used token was already used by a concurrent refresh
+	Rotate(usedRefreshToken *model.UserRefreshToken, nextRefreshToken *model.UserRefreshToken) error
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: userRefreshToken.go
+//
+// Generated by this command:
+//
+//	mockgen --source userRefreshToken.go --package repository --destination userRefreshToken_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRefreshTokenRepository is a mock of UserRefreshTokenRepository interface.
+type MockUserRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRefreshTokenRepositoryMockRecorder
+}
+
+// MockUserRefreshTokenRepositoryMockRecorder is the mock recorder for MockUserRefreshTokenRepository.
+type MockUserRefreshTokenRepositoryMockRecorder struct {
+	mock *MockUserRefreshTokenRepository
+}
+
+// NewMockUserRefreshTokenRepository creates a new mock instance.
+func NewMockUserRefreshTokenRepository(ctrl *gomock.Controller) *MockUserRefreshTokenRepository {
+	mock := &MockUserRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRefreshTokenRepository) EXPECT() *MockUserRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserRefreshTokenRepository) Create(refreshToken *model.UserRefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRefreshTokenRepositoryMockRecorder) Create(refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRefreshTokenRepository)(nil).Create), refreshToken)
+}
+
+// FindByTokenHash mocks base method.
+func (m *MockUserRefreshTokenRepository) FindByTokenHash(tokenHash string) (*model.UserRefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTokenHash", tokenHash)
+	ret0, _ := ret[0].(*model.UserRefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTokenHash indicates an expected call of FindByTokenHash.
+func (mr *MockUserRefreshTokenRepositoryMockRecorder) FindByTokenHash(tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTokenHash", reflect.TypeOf((*MockUserRefreshTokenRepository)(nil).FindByTokenHash), tokenHash)
+}
+
+// Rotate mocks base method.
+func (m *MockUserRefreshTokenRepository) Rotate(usedRefreshToken, nextRefreshToken *model.UserRefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", usedRefreshToken, nextRefreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockUserRefreshTokenRepositoryMockRecorder) Rotate(usedRefreshToken, nextRefreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockUserRefreshTokenRepository)(nil).Rotate), usedRefreshToken, nextRefreshToken)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type UserSessionRepository interface {
+	Create(session *model.UserSession) error
+	Save(session *model.UserSession) error
+	FindById(sessionId string) (*model.UserSession, error)
+	ListActiveByUser(userId string, now time.Time) ([]*model.UserSession, error)
+	UpdateLastSeenAt(sessionId string, lastSeenAt time.Time) error
+	RevokeAllByUser(userId string, now time.Time) error
+}
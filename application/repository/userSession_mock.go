@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: userSession.go
+//
+// Generated by this command:
+//
+//	mockgen --source userSession.go --package repository --destination userSession_mock.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/RuanScherer/journey-track-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserSessionRepository is a mock of UserSessionRepository interface.
+type MockUserSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSessionRepositoryMockRecorder
+}
+
+// MockUserSessionRepositoryMockRecorder is the mock recorder for MockUserSessionRepository.
+type MockUserSessionRepositoryMockRecorder struct {
+	mock *MockUserSessionRepository
+}
+
+// NewMockUserSessionRepository creates a new mock instance.
+func NewMockUserSessionRepository(ctrl *gomock.Controller) *MockUserSessionRepository {
+	mock := &MockUserSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUserSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSessionRepository) EXPECT() *MockUserSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserSessionRepository) Create(session *model.UserSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserSessionRepositoryMockRecorder) Create(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserSessionRepository)(nil).Create), session)
+}
+
+// FindById mocks base method.
+func (m *MockUserSessionRepository) FindById(sessionId string) (*model.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", sessionId)
+	ret0, _ := ret[0].(*model.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserSessionRepositoryMockRecorder) FindById(sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserSessionRepository)(nil).FindById), sessionId)
+}
+
+// ListActiveByUser mocks base method.
+func (m *MockUserSessionRepository) ListActiveByUser(userId string, now time.Time) ([]*model.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUser", userId, now)
+	ret0, _ := ret[0].([]*model.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUser indicates an expected call of ListActiveByUser.
+func (mr *MockUserSessionRepositoryMockRecorder) ListActiveByUser(userId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUser", reflect.TypeOf((*MockUserSessionRepository)(nil).ListActiveByUser), userId, now)
+}
+
+// RevokeAllByUser mocks base method.
+func (m *MockUserSessionRepository) RevokeAllByUser(userId string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUser", userId, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByUser indicates an expected call of RevokeAllByUser.
+func (mr *MockUserSessionRepositoryMockRecorder) RevokeAllByUser(userId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUser", reflect.TypeOf((*MockUserSessionRepository)(nil).RevokeAllByUser), userId, now)
+}
+
+// Save mocks base method.
+func (m *MockUserSessionRepository) Save(session *model.UserSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockUserSessionRepositoryMockRecorder) Save(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserSessionRepository)(nil).Save), session)
+}
+
+// UpdateLastSeenAt mocks base method.
+func (m *MockUserSessionRepository) UpdateLastSeenAt(sessionId string, lastSeenAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastSeenAt", sessionId, lastSeenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastSeenAt indicates an expected call of UpdateLastSeenAt.
+func (mr *MockUserSessionRepositoryMockRecorder) UpdateLastSeenAt(sessionId, lastSeenAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeenAt", reflect.TypeOf((*MockUserSessionRepository)(nil).UpdateLastSeenAt), sessionId, lastSeenAt)
+}
@@ -49,7 +49,7 @@ func (useCase *AcceptProjectInviteUseCase) Execute(req *appmodel.AnswerProjectIn
 		return appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
 	}
 
-	err = project.AddMember(projectInvite.User)
+	err = project.AddMember(projectInvite.User, projectInvite.Role)
 	if err != nil {
 		return appmodel.NewAppError("unable_to_add_project_member", err.Error(), appmodel.ErrorTypeValidation)
 	}
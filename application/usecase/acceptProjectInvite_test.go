@@ -44,7 +44,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 
 	project, _ := factory.NewProjectWithDefaultOwner("fake project")
 	user, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
-	invitation, _ := domainmodel.NewProjectInvite(project, user)
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteMockRepository.
 		EXPECT().
 		FindByProjectAndToken(req.ProjectID, req.InviteToken).
@@ -54,7 +54,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [unable_to_accept_project_invite] invalid token provided to answer invite")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteMockRepository.
 		EXPECT().
@@ -69,7 +69,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_save_project_invite_answer] unexpected error")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteMockRepository.
 		EXPECT().
@@ -88,7 +88,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [project_not_found] project not found")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteMockRepository.
 		EXPECT().
@@ -107,10 +107,10 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_find_project] unexpected error")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	invalidProject := *project
-	_ = invalidProject.AddMember(user)
+	_ = invalidProject.AddMember(user, domainmodel.ProjectRoleEditor)
 	projectInviteMockRepository.
 		EXPECT().
 		FindByProjectAndToken(req.ProjectID, req.InviteToken).
@@ -128,7 +128,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_add_project_member] user is already a member of this project")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteMockRepository.
 		EXPECT().
@@ -152,7 +152,7 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 	assert.Error(t, err, "(database) [unable_to_save_project_changes] unexpected error")
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake project")
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleViewer, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteMockRepository.
 		EXPECT().
@@ -173,4 +173,5 @@ func TestAcceptProjectInviteUseCase_Execute(t *testing.T) {
 
 	err = useCase.Execute(req)
 	assert.Nil(t, err)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, project.FindMembership(user.ID).Role)
 }
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+// AuthenticatePersonalAccessTokenUseCase identifies requests made with a personal access token as its user,
+// as long as the token scope and project allow the request
+type AuthenticatePersonalAccessTokenUseCase struct {
+	userRepository                repository.UserRepository
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+}
+
+func NewAuthenticatePersonalAccessTokenUseCase(
+	userRepository repository.UserRepository,
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+) *AuthenticatePersonalAccessTokenUseCase {
+	return &AuthenticatePersonalAccessTokenUseCase{userRepository, personalAccessTokenRepository}
+}
+
+func (useCase *AuthenticatePersonalAccessTokenUseCase) Execute(
+	req *appmodel.AuthenticatePersonalAccessTokenRequest,
+) (*appmodel.AuthenticatePersonalAccessTokenResponse, error) {
+	token, err := useCase.personalAccessTokenRepository.FindByTokenHash(model.HashPersonalAccessToken(req.Token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invalid_access_token", "invalid access token", appmodel.ErrorTypeAuthentication)
+		}
+		return nil, appmodel.NewAppError("unable_to_authenticate_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	now := time.Now()
+	if !token.IsActive(now) {
+		return nil, appmodel.NewAppError("invalid_access_token", "invalid access token", appmodel.ErrorTypeAuthentication)
+	}
+
+	// a leaked token must not be able to mint new tokens or outlive its own revocation
+	if req.ManagesCredentials {
+		return nil, appmodel.NewAppError(
+			"token_not_allowed",
+			"personal access tokens can't manage tokens or sessions",
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	if req.Write && !token.AllowsWrite() {
+		return nil, appmodel.NewAppError(
+			"token_scope_not_allowed",
+			"token scope doesn't allow this operation",
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	if !token.AllowsProject(req.ProjectID) {
+		return nil, appmodel.NewAppError(
+			"token_project_not_allowed",
+			"token is restricted to another project",
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	user, err := useCase.userRepository.FindById(token.UserID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_find_token_user", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if token.MarkUsed(now) {
+		err = useCase.personalAccessTokenRepository.UpdateLastUsedAt(token.ID, now)
+		if err != nil {
+			slog.Error("Unable to update token last used timestamp", "error", err)
+		}
+	}
+
+	return &appmodel.AuthenticatePersonalAccessTokenResponse{
+		User: appmodel.AuthUser{
+			ID:    user.ID,
+			Email: *user.Email,
+			Name:  user.Name,
+		},
+		TokenID: token.ID,
+	}, nil
+}
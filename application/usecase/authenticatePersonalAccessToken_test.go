@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestAuthenticatePersonalAccessTokenUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	personalAccessTokenRepositoryMock := repository.NewMockPersonalAccessTokenRepository(ctrl)
+	useCase := NewAuthenticatePersonalAccessTokenUseCase(userRepositoryMock, personalAccessTokenRepositoryMock)
+
+	user, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	token, plainToken, _ := domainmodel.NewPersonalAccessToken(
+		user,
+		"CI",
+		domainmodel.PersonalAccessTokenScopeRead,
+		project,
+		time.Now().Add(time.Hour),
+	)
+	req := &model.AuthenticatePersonalAccessTokenRequest{
+		Token:     plainToken,
+		ProjectID: project.ID,
+	}
+
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash(token.TokenHash).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_access_token", err.(*model.AppError).Code)
+
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash(token.TokenHash).
+		AnyTimes().
+		Return(token, nil)
+
+	_ = token.Revoke()
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_access_token", err.(*model.AppError).Code)
+
+	token.RevokedAt = nil
+	req.ManagesCredentials = true
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "token_not_allowed", err.(*model.AppError).Code)
+
+	req.ManagesCredentials = false
+	req.Write = true
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "token_scope_not_allowed", err.(*model.AppError).Code)
+
+	req.Write = false
+	req.ProjectID = "fake-other-project-id"
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "token_project_not_allowed", err.(*model.AppError).Code)
+
+	req.ProjectID = project.ID
+	userRepositoryMock.
+		EXPECT().
+		FindById(user.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_token_user", err.(*model.AppError).Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindById(user.ID).
+		AnyTimes().
+		Return(user, nil)
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		UpdateLastUsedAt(token.ID, gomock.Any()).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, user.ID, res.User.ID)
+	assert.Equal(t, token.ID, res.TokenID)
+	assert.NotNil(t, token.LastUsedAt)
+
+	// write tokens also read, and tokens without a project reach any project
+	token.Scope = domainmodel.PersonalAccessTokenScopeWrite
+	token.ProjectID = nil
+	req.Write = true
+	req.ProjectID = ""
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type AuthenticateProjectApiKeyUseCase struct {
+	projectApiKeyRepository repository.ProjectApiKeyRepository
+}
+
+func NewAuthenticateProjectApiKeyUseCase(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *AuthenticateProjectApiKeyUseCase {
+	return &AuthenticateProjectApiKeyUseCase{projectApiKeyRepository}
+}
+
+func (useCase *AuthenticateProjectApiKeyUseCase) Execute(
+	req *appmodel.AuthenticateProjectApiKeyRequest,
+) (*appmodel.AuthenticateProjectApiKeyResponse, error) {
+	apiKey, appErr := authenticateProjectApiKey(useCase.projectApiKeyRepository, req.Key, model.ProjectApiKeyScopeRead)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if apiKey.ProjectID != req.ProjectID {
+		return nil, appmodel.NewAppError(
+			"api_key_not_allowed",
+			"api key doesn't belong to the requested project",
+			appmodel.ErrorTypeAuthentication,
+		)
+	}
+
+	return &appmodel.AuthenticateProjectApiKeyResponse{
+		Principal: appmodel.Principal{
+			ApiKeyID:        apiKey.ID,
+			ApiKeyProjectID: apiKey.ProjectID,
+		},
+	}, nil
+}
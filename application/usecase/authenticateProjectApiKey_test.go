@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestAuthenticateProjectApiKeyUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewAuthenticateProjectApiKeyUseCase(projectApiKeyRepositoryMock)
+
+	apiKey, plainKey, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeRead)
+	req := &appmodel.AuthenticateProjectApiKeyRequest{
+		Key:       plainKey,
+		ProjectID: apiKey.ProjectID,
+	}
+
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(plainKey)).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
+
+	ingestApiKey, ingestPlainKey, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(ingestPlainKey)).
+		Return(ingestApiKey, nil)
+
+	res, err = useCase.Execute(&appmodel.AuthenticateProjectApiKeyRequest{
+		Key:       ingestPlainKey,
+		ProjectID: ingestApiKey.ProjectID,
+	})
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [api_key_scope_not_allowed]: api key scope doesn't allow this operation")
+
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(plainKey)).
+		AnyTimes().
+		Return(apiKey, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		UpdateLastUsedAt(apiKey.ID, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(&appmodel.AuthenticateProjectApiKeyRequest{
+		Key:       plainKey,
+		ProjectID: "another-project-id",
+	})
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [api_key_not_allowed]: api key doesn't belong to the requested project")
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, apiKey.ID, res.Principal.ApiKeyID)
+	assert.Equal(t, apiKey.ProjectID, res.Principal.ApiKeyProjectID)
+	assert.Empty(t, res.Principal.UserID)
+
+	expiredAt := time.Now().Add(-time.Minute)
+	apiKey.ExpiresAt = &expiredAt
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
+}
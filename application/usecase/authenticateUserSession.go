@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+// AuthenticateUserSessionUseCase accepts access tokens only while the session they were issued for is active
+type AuthenticateUserSessionUseCase struct {
+	jwtManager            jwt.Manager
+	userSessionRepository repository.UserSessionRepository
+}
+
+func NewAuthenticateUserSessionUseCase(
+	jwtManager jwt.Manager,
+	userSessionRepository repository.UserSessionRepository,
+) *AuthenticateUserSessionUseCase {
+	return &AuthenticateUserSessionUseCase{jwtManager, userSessionRepository}
+}
+
+func (useCase *AuthenticateUserSessionUseCase) Execute(
+	req *appmodel.AuthenticateUserSessionRequest,
+) (*appmodel.AuthenticateUserSessionResponse, error) {
+	claims, err := useCase.jwtManager.GetJwtClaims(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens issued before sessions existed have no jti, so their users need to sign in again
+	if claims.ID == "" {
+		return nil, appmodel.NewAppError("invalid_access_token", "invalid access token", appmodel.ErrorTypeAuthentication)
+	}
+
+	session, err := useCase.userSessionRepository.FindById(claims.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invalid_access_token", "invalid access token", appmodel.ErrorTypeAuthentication)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	now := time.Now()
+	if session.UserID != claims.User.ID || !session.IsActive(now) {
+		return nil, appmodel.NewAppError("revoked_session", "session expired or revoked", appmodel.ErrorTypeAuthentication)
+	}
+
+	if session.MarkSeen(now) {
+		err = useCase.userSessionRepository.UpdateLastSeenAt(session.ID, now)
+		if err != nil {
+			slog.Error("Unable to update session last seen timestamp", "error", err)
+		}
+	}
+
+	return &appmodel.AuthenticateUserSessionResponse{
+		User:      claims.User,
+		SessionID: session.ID,
+	}, nil
+}
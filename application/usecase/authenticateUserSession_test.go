@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestAuthenticateUserSessionUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jwtManagerMock := jwt.NewMockManager(ctrl)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	useCase := NewAuthenticateUserSessionUseCase(jwtManagerMock, userSessionRepositoryMock)
+
+	req := &model.AuthenticateUserSessionRequest{AccessToken: "fake-token"}
+	claims := &model.JwtClaims{User: model.AuthUser{ID: "fake-user-id"}}
+
+	jwtManagerMock.
+		EXPECT().
+		GetJwtClaims(req.AccessToken).
+		Return(nil, model.NewAppError("invalid_access_token", "invalid access token", model.ErrorTypeAuthentication))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_access_token", err.(*model.AppError).Code)
+
+	jwtManagerMock.
+		EXPECT().
+		GetJwtClaims(req.AccessToken).
+		AnyTimes().
+		Return(claims, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_access_token", err.(*model.AppError).Code)
+
+	claims.RegisteredClaims = jwtlib.RegisteredClaims{ID: "fake-session-id"}
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById("fake-session-id").
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_session", err.(*model.AppError).Code)
+
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById("fake-session-id").
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_access_token", err.(*model.AppError).Code)
+
+	now := time.Now()
+	session := &domainmodel.UserSession{
+		ID:         "fake-session-id",
+		UserID:     "fake-user-id",
+		LastSeenAt: now.Add(-time.Hour),
+		ExpiresAt:  now.Add(-time.Second),
+	}
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById("fake-session-id").
+		AnyTimes().
+		Return(session, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "revoked_session", err.(*model.AppError).Code)
+
+	session.ExpiresAt = now.Add(time.Hour)
+	session.RevokedAt = &now
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "revoked_session", err.(*model.AppError).Code)
+
+	session.RevokedAt = nil
+	userSessionRepositoryMock.
+		EXPECT().
+		UpdateLastSeenAt(session.ID, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "fake-user-id", res.User.ID)
+	assert.Equal(t, "fake-session-id", res.SessionID)
+
+	// last seen was just refreshed, so it isn't stored again
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// AuthorizeIngestionOriginUseCase answers browser preflight requests to the ingestion endpoints
+type AuthorizeIngestionOriginUseCase struct {
+	projectApiKeyRepository repository.ProjectApiKeyRepository
+}
+
+func NewAuthorizeIngestionOriginUseCase(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *AuthorizeIngestionOriginUseCase {
+	return &AuthorizeIngestionOriginUseCase{projectApiKeyRepository}
+}
+
+func (useCase *AuthorizeIngestionOriginUseCase) Execute(req *appmodel.AuthorizeIngestionOriginRequest) error {
+	apiKey, appErr := authenticateProjectApiKey(useCase.projectApiKeyRepository, req.ApiKey, model.ProjectApiKeyScopeIngest)
+	if appErr != nil {
+		return appErr
+	}
+
+	appErr = verifyIngestionOrigin(apiKey.Project, req.Origin)
+	if appErr != nil {
+		return appErr
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestAuthorizeIngestionOriginUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProjectApiKeyRepository := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewAuthorizeIngestionOriginUseCase(mockProjectApiKeyRepository)
+
+	req := &appmodel.AuthorizeIngestionOriginRequest{ApiKey: "fake-api-key", Origin: "https://example.com"}
+
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	apiKey.MarkUsed(time.Now())
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		AnyTimes().
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "origin_not_allowed", err.(*appmodel.AppError).Code)
+	assert.Equal(t, appmodel.ErrorTypeForbidden, err.(*appmodel.AppError).Type)
+
+	_ = apiKey.Project.ChangeAllowedOrigins([]string{"https://example.com"})
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/asaskevich/govalidator"
+	"gorm.io/gorm"
+)
+
+const MaxBulkInviteRows = 500
+
+// BulkInviteProjectMembersUseCase invites each csv row on its own, so a bad row never blocks the others
+type BulkInviteProjectMembersUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+	userRepository           repository.UserRepository
+	projectInviteRepository  repository.ProjectInviteRepository
+	producerFactory          kafka.ProducerFactory
+}
+
+func NewBulkInviteProjectMembersUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	userRepository repository.UserRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+	producerFactory kafka.ProducerFactory,
+) *BulkInviteProjectMembersUseCase {
+	return &BulkInviteProjectMembersUseCase{
+		projectPermissionService,
+		projectRepository,
+		userRepository,
+		projectInviteRepository,
+		producerFactory,
+	}
+}
+
+func (useCase *BulkInviteProjectMembersUseCase) Execute(
+	req *appmodel.BulkInviteProjectMembersRequest,
+) (*appmodel.BulkInviteProjectMembersResponse, error) {
+	if req.Csv == nil {
+		return nil, appmodel.NewAppError("csv_required", "csv file is required", appmodel.ErrorTypeValidation)
+	}
+
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	actor, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to invite a member",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		project.ID,
+		actor.ID,
+		ProjectPermissionManageInvites,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	rows, appErr := readBulkInviteCsv(req.Csv)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	seenEmails := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		invite := useCase.inviteRow(row, project, actorMembership, actor, seenEmails)
+		if invite != nil {
+			go queueProjectInviteInvitationEmail(useCase.projectInviteRepository, useCase.producerFactory, invite.ID, actor.Name)
+		}
+	}
+	return &appmodel.BulkInviteProjectMembersResponse{Rows: rows}, nil
+}
+
+// inviteRow fills the row status and returns the invite only when one was created for it
+func (useCase *BulkInviteProjectMembersUseCase) inviteRow(
+	row *appmodel.BulkInviteRow,
+	project *model.Project,
+	actorMembership *model.ProjectMember,
+	actor *model.User,
+	seenEmails map[string]bool,
+) *model.ProjectInvite {
+	if row.Status != "" {
+		return nil
+	}
+
+	if row.Role == "" {
+		row.Role = model.ProjectInviteDefaultRole
+	}
+
+	if !govalidator.IsEmail(row.Email) {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, "invalid email")
+		return nil
+	}
+
+	if !govalidator.IsIn(row.Role, model.ProjectRoleAdmin, model.ProjectRoleEditor, model.ProjectRoleViewer) {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, "invalid role")
+		return nil
+	}
+
+	if !actorMembership.CanAssignRole(row.Role) {
+		message := fmt.Sprintf("the %s role can't invite members as %s", actorMembership.Role, row.Role)
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, message)
+		return nil
+	}
+
+	if seenEmails[row.Email] {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, "email repeated in the file")
+		return nil
+	}
+	seenEmails[row.Email] = true
+
+	user, err := useCase.userRepository.FindByEmail(row.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusFailed, err.Error())
+		return nil
+	}
+
+	var existentInvite *model.ProjectInvite
+	if user != nil {
+		if project.HasMember(user) {
+			markBulkInviteRow(row, appmodel.BulkInviteRowStatusAlreadyMember, "")
+			return nil
+		}
+		existentInvite, err = useCase.projectInviteRepository.FindPendingByUserAndProject(user.ID, project.ID)
+	} else {
+		existentInvite, err = useCase.projectInviteRepository.FindPendingByEmailAndProject(row.Email, project.ID)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusFailed, err.Error())
+		return nil
+	}
+
+	if existentInvite != nil {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusAlreadyPending, "")
+		row.Invite = newProjectInviteResponse(existentInvite)
+		return nil
+	}
+
+	var invite *model.ProjectInvite
+	if user != nil {
+		invite, err = model.NewProjectInvite(project, user, row.Role, actor)
+	} else {
+		invite, err = model.NewProjectInviteByEmail(project, row.Email, row.Role, actor)
+	}
+	if err != nil {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, err.Error())
+		return nil
+	}
+
+	err = useCase.projectInviteRepository.Create(invite)
+	if err != nil {
+		markBulkInviteRow(row, appmodel.BulkInviteRowStatusFailed, err.Error())
+		return nil
+	}
+
+	markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvited, "")
+	row.Invite = newProjectInviteResponse(invite)
+	return invite
+}
+
+func markBulkInviteRow(row *appmodel.BulkInviteRow, status string, message string) {
+	row.Status = status
+	row.Message = message
+}
+
+// readBulkInviteCsv reads the email and optional role of each row, skipping a leading header row
+func readBulkInviteCsv(file io.Reader) ([]*appmodel.BulkInviteRow, *appmodel.AppError) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows := make([]*appmodel.BulkInviteRow, 0)
+	isFirstRecord := true
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_csv", err.Error(), appmodel.ErrorTypeValidation)
+		}
+
+		if isFirstRecord {
+			isFirstRecord = false
+			if strings.EqualFold(strings.TrimSpace(record[0]), "email") {
+				continue
+			}
+		}
+
+		if len(rows) == MaxBulkInviteRows {
+			return nil, appmodel.NewAppError(
+				"too_many_csv_rows",
+				fmt.Sprintf("csv should have at most %d rows", MaxBulkInviteRows),
+				appmodel.ErrorTypeValidation,
+			)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &appmodel.BulkInviteRow{
+			Line:  line,
+			Email: model.NormalizeProjectInviteEmail(record[0]),
+		}
+		if len(record) > 1 {
+			row.Role = strings.ToLower(strings.TrimSpace(record[1]))
+		}
+		if len(record) > 2 {
+			markBulkInviteRow(row, appmodel.BulkInviteRowStatusInvalid, "row should only have an email and a role")
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, appmodel.NewAppError("empty_csv", "csv has no rows to invite", appmodel.ErrorTypeValidation)
+	}
+	return rows, nil
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestBulkInviteProjectMembersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	useCase := NewBulkInviteProjectMembersUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		userRepositoryMock,
+		projectInviteRepositoryMock,
+		producerFactoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	actor := project.Memberships[0].User
+	req := &appmodel.BulkInviteProjectMembersRequest{
+		ActorID:   actor.ID,
+		ProjectID: project.ID,
+	}
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "csv_required", err.(*appmodel.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		AnyTimes().
+		Return(project, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(actor.ID).
+		AnyTimes().
+		Return(actor, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    actor.ID,
+			Role:      domainmodel.ProjectRoleAdmin,
+		}, nil)
+
+	req.Csv = strings.NewReader("email,role\n")
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "empty_csv", err.(*appmodel.AppError).Code)
+
+	req.Csv = strings.NewReader("jane\"doe@gmail.com,viewer\n")
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_csv", err.(*appmodel.AppError).Code)
+
+	member, _ := factory.NewVerifiedUser("member@gmail.com", "Member", "fake-password")
+	_ = project.AddMember(member, domainmodel.ProjectRoleEditor)
+	invitedUser, _ := factory.NewVerifiedUser("pending@gmail.com", "Pending", "fake-password")
+	pendingInvite, _ := domainmodel.NewProjectInvite(project, invitedUser, domainmodel.ProjectRoleViewer, actor)
+
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("new.person@gmail.com").
+		Return(nil, gorm.ErrRecordNotFound)
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("member@gmail.com").
+		Return(member, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("pending@gmail.com").
+		Return(invitedUser, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("broken@gmail.com").
+		Return(nil, errors.New("unexpected error"))
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindPendingByEmailAndProject("new.person@gmail.com", project.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindPendingByUserAndProject(invitedUser.ID, project.ID).
+		Return(pendingInvite, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindById(gomock.Any()).
+		AnyTimes().
+		Return(nil, errors.New("unexpected error"))
+
+	req.Csv = strings.NewReader(strings.Join([]string{
+		"Email,Role",
+		"New.Person@gmail.com",
+		"member@gmail.com,editor",
+		"pending@gmail.com,viewer",
+		"not-an-email,viewer",
+		"someone@gmail.com,owner",
+		"someone@gmail.com,admin",
+		"new.person@gmail.com,viewer",
+		"extra@gmail.com,viewer,unexpected",
+		"broken@gmail.com,editor",
+	}, "\n"))
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Len(t, res.Rows, 9)
+
+	expectedStatuses := []string{
+		appmodel.BulkInviteRowStatusInvited,
+		appmodel.BulkInviteRowStatusAlreadyMember,
+		appmodel.BulkInviteRowStatusAlreadyPending,
+		appmodel.BulkInviteRowStatusInvalid,
+		appmodel.BulkInviteRowStatusInvalid,
+		appmodel.BulkInviteRowStatusInvalid,
+		appmodel.BulkInviteRowStatusInvalid,
+		appmodel.BulkInviteRowStatusInvalid,
+		appmodel.BulkInviteRowStatusFailed,
+	}
+	for i, row := range res.Rows {
+		assert.Equal(t, i+2, row.Line)
+		assert.Equal(t, expectedStatuses[i], row.Status, row.Email)
+	}
+
+	assert.Equal(t, "new.person@gmail.com", res.Rows[0].Email)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, res.Rows[0].Role)
+	assert.Nil(t, res.Rows[0].Invite.User)
+	assert.Equal(t, pendingInvite.ID, res.Rows[2].Invite.ID)
+	assert.Equal(t, "invalid email", res.Rows[3].Message)
+	assert.Equal(t, "invalid role", res.Rows[4].Message)
+	assert.Equal(t, "the admin role can't invite members as admin", res.Rows[5].Message)
+	assert.Equal(t, "email repeated in the file", res.Rows[6].Message)
+	assert.Equal(t, "row should only have an email and a role", res.Rows[7].Message)
+	assert.Equal(t, "unexpected error", res.Rows[8].Message)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+type ChangeProjectMemberRoleUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectMemberRepository  repository.ProjectMemberRepository
+}
+
+func NewChangeProjectMemberRoleUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectMemberRepository repository.ProjectMemberRepository,
+) *ChangeProjectMemberRoleUseCase {
+	return &ChangeProjectMemberRoleUseCase{projectPermissionService, projectMemberRepository}
+}
+
+func (useCase *ChangeProjectMemberRoleUseCase) Execute(
+	req *appmodel.ChangeProjectMemberRoleRequest,
+) (*appmodel.ChangeProjectMemberRoleResponse, error) {
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		req.ProjectID,
+		req.ActorID,
+		ProjectPermissionManageMembers,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if req.MemberID == req.ActorID {
+		return nil, appmodel.NewAppError(
+			"unable_to_change_own_role",
+			"members can't change their own role",
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	member, err := useCase.projectMemberRepository.FindByProjectAndUser(req.ProjectID, req.MemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError(
+				"project_member_not_found",
+				"project member not found",
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project_member", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if !actorMembership.CanAssignRole(member.Role) || !actorMembership.CanAssignRole(req.Role) {
+		return nil, appmodel.NewAppError(
+			"role_not_assignable",
+			fmt.Sprintf(
+				"the %s role can't change members from %s to %s",
+				actorMembership.Role,
+				member.Role,
+				req.Role,
+			),
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	err = member.ChangeRole(req.Role)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_change_member_role", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectMemberRepository.Save(member)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_project_member", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return &appmodel.ChangeProjectMemberRoleResponse{
+		ProjectID: member.ProjectID,
+		MemberID:  member.UserID,
+		Role:      member.Role,
+	}, nil
+}
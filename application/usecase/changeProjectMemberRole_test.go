@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestChangeProjectMemberRoleUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewChangeProjectMemberRoleUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectMemberRepositoryMock,
+	)
+
+	req := &appmodel.ChangeProjectMemberRoleRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: "fake-project-id",
+		MemberID:  "fake-member-id",
+		Role:      domainmodel.ProjectRoleViewer,
+	}
+	newMember := func(userID string, role string) *domainmodel.ProjectMember {
+		return &domainmodel.ProjectMember{ProjectID: req.ProjectID, UserID: userID, Role: role}
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(newMember(req.ActorID, domainmodel.ProjectRoleEditor), nil)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(newMember(req.ActorID, domainmodel.ProjectRoleAdmin), nil)
+
+	req.MemberID = req.ActorID
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_change_own_role", err.(*appmodel.AppError).Code)
+
+	req.MemberID = "fake-member-id"
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.MemberID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_member_not_found", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.MemberID).
+		Return(newMember(req.MemberID, domainmodel.ProjectRoleOwner), nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "role_not_assignable", err.(*appmodel.AppError).Code)
+
+	req.Role = domainmodel.ProjectRoleAdmin
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.MemberID).
+		Return(newMember(req.MemberID, domainmodel.ProjectRoleEditor), nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "role_not_assignable", err.(*appmodel.AppError).Code)
+
+	req.Role = domainmodel.ProjectRoleViewer
+	member := newMember(req.MemberID, domainmodel.ProjectRoleEditor)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.MemberID).
+		AnyTimes().
+		Return(member, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		Save(member).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_save_project_member]: unexpected error")
+
+	member.Role = domainmodel.ProjectRoleEditor
+	projectMemberRepositoryMock.
+		EXPECT().
+		Save(member).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, req.ProjectID, res.ProjectID)
+	assert.Equal(t, req.MemberID, res.MemberID)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, res.Role)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, member.Role)
+}
+
+func TestRoleHasProjectPermission(t *testing.T) {
+	assert.True(t, RoleHasProjectPermission(domainmodel.ProjectRoleViewer, ProjectPermissionViewAnalytics))
+	assert.False(t, RoleHasProjectPermission(domainmodel.ProjectRoleViewer, ProjectPermissionManageInvites))
+	assert.True(t, RoleHasProjectPermission(domainmodel.ProjectRoleEditor, ProjectPermissionManageApiKeys))
+	assert.False(t, RoleHasProjectPermission(domainmodel.ProjectRoleEditor, ProjectPermissionManageInvites))
+	assert.True(t, RoleHasProjectPermission(domainmodel.ProjectRoleAdmin, ProjectPermissionManageInvites))
+	assert.False(t, RoleHasProjectPermission(domainmodel.ProjectRoleAdmin, ProjectPermissionDeleteProject))
+	assert.True(t, RoleHasProjectPermission(domainmodel.ProjectRoleOwner, ProjectPermissionDeleteProject))
+	assert.False(t, RoleHasProjectPermission(domainmodel.ProjectRoleAdmin, ProjectPermissionTransferOwnership))
+	assert.True(t, RoleHasProjectPermission(domainmodel.ProjectRoleOwner, ProjectPermissionTransferOwnership))
+	assert.False(t, RoleHasProjectPermission("guest", ProjectPermissionViewProject))
+}
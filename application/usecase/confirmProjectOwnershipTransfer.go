@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+type ConfirmProjectOwnershipTransferUseCase struct {
+	projectRepository                  repository.ProjectRepository
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository
+}
+
+func NewConfirmProjectOwnershipTransferUseCase(
+	projectRepository repository.ProjectRepository,
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository,
+) *ConfirmProjectOwnershipTransferUseCase {
+	return &ConfirmProjectOwnershipTransferUseCase{projectRepository, projectOwnershipTransferRepository}
+}
+
+func (useCase *ConfirmProjectOwnershipTransferUseCase) Execute(
+	req *appmodel.ConfirmProjectOwnershipTransferRequest,
+) (*appmodel.ProjectOwnershipTransfer, error) {
+	transfer, err := useCase.projectOwnershipTransferRepository.FindByProjectAndToken(req.ProjectID, req.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError(
+				"ownership_transfer_not_found",
+				"ownership transfer not found",
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_ownership_transfer", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if transfer.ToUserID != req.ActorID {
+		return nil, appmodel.NewAppError(
+			"not_ownership_transfer_nominee",
+			"only the nominee can confirm the ownership transfer",
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	project, err := useCase.projectRepository.FindById(transfer.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	err = transfer.Confirm(project, req.Token, time.Now())
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_confirm_ownership_transfer",
+			err.Error(),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	err = useCase.projectOwnershipTransferRepository.Confirm(transfer, project)
+	if err != nil {
+		// the owner changed between loading the project and applying the transfer
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError(
+				"unable_to_confirm_ownership_transfer",
+				"project ownership changed since the transfer was requested",
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		return nil, appmodel.NewAppError("unable_to_transfer_ownership", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return newProjectOwnershipTransferResponse(transfer), nil
+}
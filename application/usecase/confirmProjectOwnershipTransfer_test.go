@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestConfirmProjectOwnershipTransferUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	projectOwnershipTransferRepositoryMock := repository.NewMockProjectOwnershipTransferRepository(ctrl)
+	useCase := NewConfirmProjectOwnershipTransferUseCase(projectRepositoryMock, projectOwnershipTransferRepositoryMock)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	previousOwnerID := project.OwnerID
+	nominee, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	_ = project.AddMember(nominee, domainmodel.ProjectRoleEditor)
+	transfer, _ := domainmodel.NewProjectOwnershipTransfer(project, nominee)
+
+	req := &model.ConfirmProjectOwnershipTransferRequest{
+		ActorID:   previousOwnerID,
+		ProjectID: project.ID,
+		Token:     *transfer.Token,
+	}
+
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindByProjectAndToken(req.ProjectID, req.Token).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "ownership_transfer_not_found", err.(*model.AppError).Code)
+
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindByProjectAndToken(req.ProjectID, req.Token).
+		AnyTimes().
+		Return(transfer, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_ownership_transfer_nominee", err.(*model.AppError).Code)
+	assert.Equal(t, model.ErrorTypeForbidden, err.(*model.AppError).Type)
+
+	req.ActorID = nominee.ID
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_project", err.(*model.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		AnyTimes().
+		Return(project, nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		Confirm(transfer, project).
+		Return(gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_confirm_ownership_transfer", err.(*model.AppError).Code)
+
+	// the transfer was applied in memory, so the project is handed back before retrying
+	project.OwnerID = previousOwnerID
+	project.FindMembership(previousOwnerID).Role = domainmodel.ProjectRoleOwner
+	project.FindMembership(nominee.ID).Role = domainmodel.ProjectRoleEditor
+	transfer.Status = domainmodel.ProjectOwnershipTransferStatusPending
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		Confirm(transfer, project).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, domainmodel.ProjectOwnershipTransferStatusConfirmed, res.Status)
+	assert.NotNil(t, res.ConfirmedAt)
+	assert.Equal(t, nominee.ID, project.OwnerID)
+	assert.Equal(t, domainmodel.ProjectRoleAdmin, project.FindMembership(previousOwnerID).Role)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_confirm_ownership_transfer", err.(*model.AppError).Code)
+}
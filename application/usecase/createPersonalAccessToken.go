@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type CreatePersonalAccessTokenUseCase struct {
+	projectPermissionService      *ProjectPermissionService
+	projectRepository             repository.ProjectRepository
+	userRepository                repository.UserRepository
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+}
+
+func NewCreatePersonalAccessTokenUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	userRepository repository.UserRepository,
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+) *CreatePersonalAccessTokenUseCase {
+	return &CreatePersonalAccessTokenUseCase{
+		projectPermissionService,
+		projectRepository,
+		userRepository,
+		personalAccessTokenRepository,
+	}
+}
+
+func (useCase *CreatePersonalAccessTokenUseCase) Execute(
+	req *appmodel.CreatePersonalAccessTokenRequest,
+) (*appmodel.CreatePersonalAccessTokenResponse, error) {
+	actor, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to create the token",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	var project *model.Project
+	if req.ProjectID != nil {
+		project, err = useCase.projectRepository.FindById(*req.ProjectID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+			}
+			return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+		}
+
+		_, appErr := useCase.projectPermissionService.Authorize(project.ID, actor.ID, ProjectPermissionViewProject)
+		if appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+	token, plainToken, err := model.NewPersonalAccessToken(actor, req.Name, req.Scope, project, expiresAt)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_create_token", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.personalAccessTokenRepository.Create(token)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return &appmodel.CreatePersonalAccessTokenResponse{
+		PersonalAccessToken: newPersonalAccessTokenResponse(token),
+		Token:               plainToken,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreatePersonalAccessTokenUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	personalAccessTokenRepositoryMock := repository.NewMockPersonalAccessTokenRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewCreatePersonalAccessTokenUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		userRepositoryMock,
+		personalAccessTokenRepositoryMock,
+	)
+
+	projectID := "fake-project-id"
+	req := &appmodel.CreatePersonalAccessTokenRequest{
+		ActorID:       "fake-actor-id",
+		Name:          "CI",
+		Scope:         domainmodel.PersonalAccessTokenScopeRead,
+		ProjectID:     &projectID,
+		ExpiresInDays: 30,
+	}
+
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_identify_user", err.(*appmodel.AppError).Code)
+
+	actor, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		AnyTimes().
+		Return(actor, nil)
+	projectRepositoryMock.
+		EXPECT().
+		FindById(projectID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_not_found", err.(*appmodel.AppError).Code)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	projectRepositoryMock.
+		EXPECT().
+		FindById(projectID).
+		AnyTimes().
+		Return(project, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_project_member", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{ProjectID: project.ID, UserID: actor.ID, Role: domainmodel.ProjectRoleViewer}, nil)
+
+	req.ExpiresInDays = 400
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_data_to_create_token", err.(*appmodel.AppError).Code)
+
+	req.ExpiresInDays = 30
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_token", err.(*appmodel.AppError).Code)
+
+	var createdToken *domainmodel.PersonalAccessToken
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(token *domainmodel.PersonalAccessToken) error {
+			createdToken = token
+			return nil
+		})
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, domainmodel.HashPersonalAccessToken(res.Token), createdToken.TokenHash)
+	assert.Equal(t, createdToken.ID, res.PersonalAccessToken.ID)
+	assert.Equal(t, project.ID, *res.PersonalAccessToken.ProjectID)
+	assert.Equal(t, domainmodel.PersonalAccessTokenScopeRead, res.PersonalAccessToken.Scope)
+}
@@ -9,17 +9,20 @@ import (
 )
 
 type CreateProjectUseCase struct {
-	projectRepository repository2.ProjectRepository
-	userRepository    repository2.UserRepository
+	projectRepository       repository2.ProjectRepository
+	userRepository          repository2.UserRepository
+	projectApiKeyRepository repository2.ProjectApiKeyRepository
 }
 
 func NewCreateProjectUseCase(
 	projectRepository repository2.ProjectRepository,
 	userRepository repository2.UserRepository,
+	projectApiKeyRepository repository2.ProjectApiKeyRepository,
 ) *CreateProjectUseCase {
 	return &CreateProjectUseCase{
 		projectRepository,
 		userRepository,
+		projectApiKeyRepository,
 	}
 }
 
@@ -46,9 +49,27 @@ func (useCase *CreateProjectUseCase) Execute(req *appmodel.CreateProjectRequest)
 		return nil, appmodel.NewAppError("unable_to_save_project", "unable to save project", appmodel.ErrorTypeDatabase)
 	}
 
+	// projects start with an ingest key so they can receive events right away
+	apiKey, plainKey, err := model.NewProjectApiKey(
+		project,
+		model.ProjectApiKeyDefaultName,
+		model.ProjectApiKeyScopeIngest,
+		ownerUser,
+	)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_create_api_key", err.Error(), appmodel.ErrorTypeServer)
+	}
+
+	err = useCase.projectApiKeyRepository.Register(apiKey)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
 	return &appmodel.CreateProjectResponse{
-		ID:      project.ID,
-		Name:    project.Name,
-		OwnerID: project.OwnerID,
+		ID:           project.ID,
+		Name:         project.Name,
+		OwnerID:      project.OwnerID,
+		IngestApiKey: newProjectApiKeyResponse(apiKey),
+		IngestKey:    plainKey,
 	}, nil
 }
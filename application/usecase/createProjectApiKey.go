@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"errors"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type CreateProjectApiKeyUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+	userRepository           repository.UserRepository
+	projectApiKeyRepository  repository.ProjectApiKeyRepository
+}
+
+func NewCreateProjectApiKeyUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	userRepository repository.UserRepository,
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *CreateProjectApiKeyUseCase {
+	return &CreateProjectApiKeyUseCase{projectPermissionService, projectRepository, userRepository, projectApiKeyRepository}
+}
+
+func (useCase *CreateProjectApiKeyUseCase) Execute(
+	req *appmodel.CreateProjectApiKeyRequest,
+) (*appmodel.CreateProjectApiKeyResponse, error) {
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	actor, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to create the api key",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	_, appErr := useCase.projectPermissionService.Authorize(project.ID, actor.ID, ProjectPermissionManageApiKeys)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	apiKey, plainKey, err := model.NewProjectApiKey(project, req.Name, req.Scope, actor)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_create_api_key", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	if req.AllowUnsigned {
+		err = apiKey.AllowUnsignedIngestion()
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_data_to_create_api_key", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
+	err = useCase.projectApiKeyRepository.Register(apiKey)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return &appmodel.CreateProjectApiKeyResponse{
+		ApiKey: newProjectApiKeyResponse(apiKey),
+		Key:    plainKey,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateProjectApiKeyUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewCreateProjectApiKeyUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		userRepositoryMock,
+		projectApiKeyRepositoryMock,
+	)
+
+	req := &appmodel.CreateProjectApiKeyRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: "fake-project-id",
+		Name:      "Website",
+		Scope:     domainmodel.ProjectApiKeyScopeIngest,
+	}
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [project_not_found]: project not found")
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		AnyTimes().
+		Return(project, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_identify_user]: unable to identify the user trying to create the api key")
+
+	randomUser, _ := factory.NewVerifiedUser("random@gmail.com", "random user", "fake-password")
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(randomUser, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, randomUser.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_project_member", err.(*appmodel.AppError).Code)
+
+	viewer, _ := factory.NewVerifiedUser("viewer@gmail.com", "viewer", "fake-password")
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(viewer, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, viewer.ID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    viewer.ID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		AnyTimes().
+		Return(project.Memberships[0].User, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.Memberships[0].User.ID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_save_api_key]: unexpected error")
+
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "Website", res.ApiKey.Name)
+	assert.Equal(t, domainmodel.ProjectApiKeyScopeIngest, res.ApiKey.Scope)
+	assert.Equal(t, res.Key[:len(res.ApiKey.Prefix)], res.ApiKey.Prefix)
+}
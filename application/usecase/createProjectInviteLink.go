@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type CreateProjectInviteLinkUseCase struct {
+	projectPermissionService    *ProjectPermissionService
+	projectRepository           repository.ProjectRepository
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository
+}
+
+func NewCreateProjectInviteLinkUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository,
+) *CreateProjectInviteLinkUseCase {
+	return &CreateProjectInviteLinkUseCase{projectPermissionService, projectRepository, projectInviteLinkRepository}
+}
+
+func (useCase *CreateProjectInviteLinkUseCase) Execute(
+	req *appmodel.CreateProjectInviteLinkRequest,
+) (*appmodel.ProjectInviteLink, error) {
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		req.ProjectID,
+		req.ActorID,
+		ProjectPermissionManageInvites,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	role := req.Role
+	if role == "" {
+		role = model.ProjectRoleViewer
+	}
+	if !actorMembership.CanAssignRole(role) {
+		return nil, appmodel.NewAppError(
+			"role_not_assignable",
+			fmt.Sprintf("the %s role can't create invite links as %s", actorMembership.Role, role),
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	expiresAt := time.Now().Add(model.ProjectInviteLinkTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	link, err := model.NewProjectInviteLink(project, actorMembership, role, req.MaxUses, expiresAt)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_create_invite_link", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectInviteLinkRepository.Create(link)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_invite_link", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return newProjectInviteLinkResponse(link), nil
+}
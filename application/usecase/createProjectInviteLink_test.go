@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateProjectInviteLinkUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	projectInviteLinkRepositoryMock := repository.NewMockProjectInviteLinkRepository(ctrl)
+	useCase := NewCreateProjectInviteLinkUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		projectInviteLinkRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	req := &appmodel.CreateProjectInviteLinkRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: project.ID,
+		Role:      domainmodel.ProjectRoleAdmin,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleAdmin,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "role_not_assignable", err.(*appmodel.AppError).Code)
+
+	req.Role = ""
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_not_found", err.(*appmodel.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		AnyTimes().
+		Return(project, nil)
+	maxUses := 0
+	req.MaxUses = &maxUses
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_data_to_create_invite_link", err.(*appmodel.AppError).Code)
+
+	maxUses = 10
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_invite_link", err.(*appmodel.AppError).Code)
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	req.ExpiresAt = &expiresAt
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, res.Role)
+	assert.Equal(t, 10, *res.MaxUses)
+	assert.Equal(t, expiresAt, res.ExpiresAt)
+	assert.True(t, res.Active)
+	assert.Contains(t, res.Url, res.Token)
+}
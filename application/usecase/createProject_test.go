@@ -5,6 +5,7 @@ import (
 	"github.com/RuanScherer/journey-track-api/application/factory"
 	"github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -15,7 +16,8 @@ func TestCreateProjectUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
-	useCase := NewCreateProjectUseCase(projectRepositoryMock, userRepositoryMock)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewCreateProjectUseCase(projectRepositoryMock, userRepositoryMock, projectApiKeyRepositoryMock)
 
 	req := &model.CreateProjectRequest{
 		OwnerID: "fake-owner-id",
@@ -68,7 +70,26 @@ func TestCreateProjectUseCase_Execute(t *testing.T) {
 	projectRepositoryMock.
 		EXPECT().
 		Register(gomock.Any()).
+		AnyTimes().
 		Return(nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_api_key", err.(*model.AppError).Code)
+
+	var apiKey *domainmodel.ProjectApiKey
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		DoAndReturn(func(createdApiKey *domainmodel.ProjectApiKey) error {
+			apiKey = createdApiKey
+			return nil
+		})
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, err)
@@ -76,4 +97,8 @@ func TestCreateProjectUseCase_Execute(t *testing.T) {
 	assert.NotEmpty(t, res.ID)
 	assert.Equal(t, req.Name, res.Name)
 	assert.Equal(t, user.ID, res.OwnerID)
+	assert.Equal(t, res.ID, apiKey.ProjectID)
+	assert.Equal(t, domainmodel.ProjectApiKeyScopeIngest, apiKey.Scope)
+	assert.Equal(t, apiKey.ID, res.IngestApiKey.ID)
+	assert.Equal(t, domainmodel.HashProjectApiKey(res.IngestKey), apiKey.KeyHash)
 }
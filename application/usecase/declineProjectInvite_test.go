@@ -42,7 +42,7 @@ func TestDeclineProjectInviteUseCase_Execute(t *testing.T) {
 
 	project, _ := factory.NewProjectWithDefaultOwner("fake project")
 	user, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Dode", "fake-password")
-	invitation, _ := domainmodel.NewProjectInvite(project, user)
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteRepositoryMock.
 		EXPECT().
 		FindByProjectAndToken(req.ProjectID, req.InviteToken).
@@ -52,7 +52,7 @@ func TestDeclineProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [unable_to_decline_project_invite] invalid token provided to answer invite")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteRepositoryMock.
 		EXPECT().
@@ -67,7 +67,7 @@ func TestDeclineProjectInviteUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_save_project_invite_answer] unexpected error")
 
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	req.InviteToken = *invitation.Token
 	projectInviteRepositoryMock.
 		EXPECT().
@@ -8,11 +8,15 @@ import (
 )
 
 type DeleteProjectUseCase struct {
-	projectRepository repository.ProjectRepository
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
 }
 
-func NewDeleteProjectUseCase(projectRepository repository.ProjectRepository) *DeleteProjectUseCase {
-	return &DeleteProjectUseCase{projectRepository}
+func NewDeleteProjectUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+) *DeleteProjectUseCase {
+	return &DeleteProjectUseCase{projectPermissionService, projectRepository}
 }
 
 func (useCase *DeleteProjectUseCase) Execute(req *appmodel.DeleteProjectRequest) error {
@@ -24,12 +28,9 @@ func (useCase *DeleteProjectUseCase) Execute(req *appmodel.DeleteProjectRequest)
 		return appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
 	}
 
-	if project.OwnerID != req.ActorID {
-		return appmodel.NewAppError(
-			"not_project_owner",
-			"only project owner can delete project",
-			appmodel.ErrorTypeValidation,
-		)
+	_, appErr := useCase.projectPermissionService.Authorize(project.ID, req.ActorID, ProjectPermissionDeleteProject)
+	if appErr != nil {
+		return appErr
 	}
 
 	err = useCase.projectRepository.DeleteById(req.ProjectID)
@@ -5,6 +5,7 @@ import (
 	"github.com/RuanScherer/journey-track-api/application/factory"
 	"github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -14,7 +15,8 @@ import (
 func TestDeleteProjectUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
-	useCase := NewDeleteProjectUseCase(projectRepositoryMock)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewDeleteProjectUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectRepositoryMock)
 
 	req := &model.DeleteProjectRequest{
 		ActorID:   "fake-actor-id",
@@ -45,12 +47,25 @@ func TestDeleteProjectUseCase_Execute(t *testing.T) {
 		FindById(req.ProjectID).
 		AnyTimes().
 		Return(project, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleAdmin,
+		}, nil)
 
 	err = useCase.Execute(req)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [not_project_owner] only project owner can delete project")
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
 
 	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
 	projectRepositoryMock.
 		EXPECT().
 		DeleteById(req.ProjectID).
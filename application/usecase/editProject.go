@@ -8,11 +8,15 @@ import (
 )
 
 type EditProjectUseCase struct {
-	projectRepository repository.ProjectRepository
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
 }
 
-func NewEditProjectUseCase(projectRepository repository.ProjectRepository) *EditProjectUseCase {
-	return &EditProjectUseCase{projectRepository}
+func NewEditProjectUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+) *EditProjectUseCase {
+	return &EditProjectUseCase{projectPermissionService, projectRepository}
 }
 
 func (useCase *EditProjectUseCase) Execute(req *appmodel.EditProjectRequest) (*appmodel.EditProjectResponse, error) {
@@ -24,12 +28,9 @@ func (useCase *EditProjectUseCase) Execute(req *appmodel.EditProjectRequest) (*a
 		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
 	}
 
-	if project.OwnerID != req.ActorID {
-		return nil, appmodel.NewAppError(
-			"not_project_owner",
-			"only project owner can edit project",
-			appmodel.ErrorTypeValidation,
-		)
+	_, appErr := useCase.projectPermissionService.Authorize(project.ID, req.ActorID, ProjectPermissionManageSettings)
+	if appErr != nil {
+		return nil, appErr
 	}
 
 	err = project.ChangeName(req.Name)
@@ -37,6 +38,20 @@ func (useCase *EditProjectUseCase) Execute(req *appmodel.EditProjectRequest) (*a
 		return nil, appmodel.NewAppError("unable_to_edit_project", err.Error(), appmodel.ErrorTypeValidation)
 	}
 
+	if req.AllowedOrigins != nil {
+		err = project.ChangeAllowedOrigins(*req.AllowedOrigins)
+		if err != nil {
+			return nil, appmodel.NewAppError("unable_to_edit_project", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
+	if req.RequireSignedIngestion != nil {
+		err = project.ChangeSignedIngestionRequirement(*req.RequireSignedIngestion)
+		if err != nil {
+			return nil, appmodel.NewAppError("unable_to_edit_project", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
 	err = useCase.projectRepository.Save(project)
 	if err != nil {
 		return nil, appmodel.NewAppError(
@@ -47,8 +62,10 @@ func (useCase *EditProjectUseCase) Execute(req *appmodel.EditProjectRequest) (*a
 	}
 
 	return &appmodel.EditProjectResponse{
-		ID:      project.ID,
-		Name:    project.Name,
-		OwnerID: project.OwnerID,
+		ID:                     project.ID,
+		Name:                   project.Name,
+		OwnerID:                project.OwnerID,
+		RequireSignedIngestion: project.RequireSignedIngestion,
+		AllowedOrigins:         project.AllowedOrigins,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/RuanScherer/journey-track-api/application/factory"
 	"github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -14,7 +15,8 @@ import (
 func TestEditProjectUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
-	useCase := NewEditProjectUseCase(projectRepositoryMock)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewEditProjectUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectRepositoryMock)
 
 	req := &model.EditProjectRequest{
 		ActorID:   "fake-actor-id",
@@ -48,13 +50,26 @@ func TestEditProjectUseCase_Execute(t *testing.T) {
 		FindById(req.ProjectID).
 		AnyTimes().
 		Return(project, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [not_project_owner] only project owner can edit project")
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
 
 	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
@@ -82,4 +97,39 @@ func TestEditProjectUseCase_Execute(t *testing.T) {
 	assert.Equal(t, project.ID, res.ID)
 	assert.Equal(t, req.Name, res.Name)
 	assert.Equal(t, project.OwnerID, res.OwnerID)
+
+	requireSignedIngestion := true
+	req.RequireSignedIngestion = &requireSignedIngestion
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [unable_to_edit_project] [project] Signing secret is required to enforce signed ingestion")
+
+	_, _ = project.RotateSigningSecret()
+	projectRepositoryMock.
+		EXPECT().
+		Save(project).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.True(t, res.RequireSignedIngestion)
+
+	req.AllowedOrigins = &[]string{"not an origin"}
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [unable_to_edit_project] [project] Invalid origin \"not an origin\"")
+
+	req.AllowedOrigins = &[]string{"https://example.com/"}
+	projectRepositoryMock.
+		EXPECT().
+		Save(project).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []string{"https://example.com"}, res.AllowedOrigins)
 }
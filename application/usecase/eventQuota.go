@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// reserveEventQuota counts eventCount events in the current month usage before they're tracked, failing when
+// they would exceed the project quota. The usage is reserved atomically, so concurrent requests can't both take
+// the remaining quota, and must be released with releaseEventQuota when the events aren't tracked.
+func reserveEventQuota(
+	projectUsageRepository repository.ProjectUsageRepository,
+	project *model.Project,
+	eventCount int,
+	now time.Time,
+) error {
+	if eventCount == 0 {
+		return nil
+	}
+
+	quota := config.GetAppConfig().EventMonthlyQuota
+	if project.MonthlyEventQuota != nil {
+		quota = *project.MonthlyEventQuota
+	}
+	if quota <= 0 {
+		err := projectUsageRepository.IncrementEventCount(project.ID, model.UsagePeriodStart(now), eventCount)
+		if err != nil {
+			return appmodel.NewAppError("unable_to_reserve_event_quota", err.Error(), appmodel.ErrorTypeDatabase)
+		}
+		return nil
+	}
+
+	reserved, err := projectUsageRepository.ReserveEventCount(project.ID, model.UsagePeriodStart(now), eventCount, quota)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_reserve_event_quota", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if !reserved {
+		periodEnd := model.UsagePeriodEnd(now)
+		return appmodel.NewLimitExceededError(
+			"event_quota_exceeded",
+			fmt.Sprintf("project monthly quota of %d events exceeded", quota),
+			appmodel.LimitKindQuota,
+			quota,
+			periodEnd,
+			periodEnd.Sub(now),
+		)
+	}
+	return nil
+}
+
+// releaseEventQuota gives back the usage reserved for events that couldn't be tracked, the request already
+// failed so failing to release it is only logged
+func releaseEventQuota(
+	projectUsageRepository repository.ProjectUsageRepository,
+	project *model.Project,
+	eventCount int,
+	now time.Time,
+) {
+	if eventCount == 0 {
+		return
+	}
+
+	err := projectUsageRepository.ReleaseEventCount(project.ID, model.UsagePeriodStart(now), eventCount)
+	if err != nil {
+		slog.Error("Unable to release event quota", "error", err)
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+const MaxTimeSeriesBuckets = 5000
+
+type GetProjectEventsTimeSeriesUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	eventRepository          repository.EventRepository
+}
+
+func NewGetProjectEventsTimeSeriesUseCase(
+	projectPermissionService *ProjectPermissionService,
+	eventRepository repository.EventRepository,
+) *GetProjectEventsTimeSeriesUseCase {
+	return &GetProjectEventsTimeSeriesUseCase{projectPermissionService, eventRepository}
+}
+
+func (useCase *GetProjectEventsTimeSeriesUseCase) Execute(
+	req *appmodel.GetProjectEventsTimeSeriesRequest,
+) (*appmodel.GetProjectEventsTimeSeriesResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	location, appErr := loadTimezone(req.Timezone)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	bucketStarts := make([]time.Time, 0)
+	bucketStart := truncateToInterval(req.From.In(location), req.Granularity)
+	for bucketStart.Before(req.To) {
+		if len(bucketStarts) == MaxTimeSeriesBuckets {
+			return nil, appmodel.NewAppError(
+				"too_many_buckets",
+				fmt.Sprintf("time range should have at most %d buckets", MaxTimeSeriesBuckets),
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		bucketStarts = append(bucketStarts, bucketStart)
+		bucketStart = addIntervals(bucketStart, req.Granularity, 1)
+	}
+
+	counts, err := useCase.eventRepository.CountByInterval(repository.EventCountByIntervalOptions{
+		ProjectID: req.ProjectID,
+		Name:      req.Name,
+		From:      req.From,
+		To:        req.To,
+		Interval:  req.Granularity,
+		Timezone:  location.String(),
+	})
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_load_stats", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	countsByBucketStart := make(map[int64]int, len(counts))
+	for _, count := range counts {
+		countsByBucketStart[wallClockIn(count.IntervalStart, location).Unix()] += count.Count
+	}
+
+	response := &appmodel.GetProjectEventsTimeSeriesResponse{
+		Granularity: req.Granularity,
+		Timezone:    location.String(),
+		Buckets:     make([]*appmodel.EventsTimeSeriesBucket, 0, len(bucketStarts)),
+	}
+	for _, bucketStart := range bucketStarts {
+		response.Buckets = append(response.Buckets, &appmodel.EventsTimeSeriesBucket{
+			Start: bucketStart,
+			Count: countsByBucketStart[bucketStart.Unix()],
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectEventsTimeSeriesUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	eventRepositoryMock := repository.NewMockEventRepository(ctrl)
+	useCase := NewGetProjectEventsTimeSeriesUseCase(NewProjectPermissionService(projectMemberRepositoryMock), eventRepositoryMock)
+
+	req := &model.GetProjectEventsTimeSeriesRequest{
+		Principal:   model.Principal{UserID: "fake-actor-id"},
+		ProjectID:   "fake-project-id",
+		Granularity: TimeIntervalDay,
+		From:        time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC),
+		To:          time.Date(2024, 3, 13, 3, 0, 0, 0, time.UTC),
+		Name:        "fake event",
+		Timezone:    "Invalid/Timezone",
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project stats")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_timezone", err.(*model.AppError).Code)
+
+	req.Timezone = "America/Sao_Paulo"
+	req.From, req.To = req.To, req.From
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_time_range", err.(*model.AppError).Code)
+
+	req.From, req.To = req.To, req.From
+	req.Granularity = TimeIntervalMinute
+	req.To = req.From.Add(365 * 24 * time.Hour)
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "too_many_buckets", err.(*model.AppError).Code)
+
+	req.Granularity = TimeIntervalDay
+	req.To = time.Date(2024, 3, 13, 3, 0, 0, 0, time.UTC)
+	expectedOptions := repository.EventCountByIntervalOptions{
+		ProjectID: req.ProjectID,
+		Name:      req.Name,
+		From:      req.From,
+		To:        req.To,
+		Interval:  TimeIntervalDay,
+		Timezone:  "America/Sao_Paulo",
+	}
+	eventRepositoryMock.
+		EXPECT().
+		CountByInterval(expectedOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_stats]: unexpected error")
+
+	eventRepositoryMock.
+		EXPECT().
+		CountByInterval(expectedOptions).
+		Return([]*repository.EventIntervalCount{
+			{IntervalStart: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), Count: 4},
+			{IntervalStart: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC), Count: 7},
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, TimeIntervalDay, res.Granularity)
+	assert.Equal(t, "America/Sao_Paulo", res.Timezone)
+	location, _ := time.LoadLocation("America/Sao_Paulo")
+	assert.Len(t, res.Buckets, 3)
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, location), res.Buckets[0].Start)
+	assert.Equal(t, 4, res.Buckets[0].Count)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, location), res.Buckets[1].Start)
+	assert.Equal(t, 0, res.Buckets[1].Count)
+	assert.Equal(t, time.Date(2024, 3, 12, 0, 0, 0, 0, location), res.Buckets[2].Start)
+	assert.Equal(t, 7, res.Buckets[2].Count)
+}
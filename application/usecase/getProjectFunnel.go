@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+const (
+	MinFunnelSteps                    = 2
+	MaxFunnelSteps                    = 10
+	DefaultFunnelConversionWindowDays = 7
+	MaxFunnelConversionWindowDays     = 90
+)
+
+type GetProjectFunnelUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	eventRepository          repository.EventRepository
+}
+
+func NewGetProjectFunnelUseCase(
+	projectPermissionService *ProjectPermissionService,
+	eventRepository repository.EventRepository,
+) *GetProjectFunnelUseCase {
+	return &GetProjectFunnelUseCase{projectPermissionService, eventRepository}
+}
+
+func (useCase *GetProjectFunnelUseCase) Execute(
+	req *appmodel.GetProjectFunnelRequest,
+) (*appmodel.GetProjectFunnelResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	appErr = validateFunnelSteps(req.Steps)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	conversionWindowDays := req.ConversionWindowDays
+	if conversionWindowDays == 0 {
+		conversionWindowDays = DefaultFunnelConversionWindowDays
+	}
+	if conversionWindowDays < 0 || conversionWindowDays > MaxFunnelConversionWindowDays {
+		return nil, appmodel.NewAppError(
+			"invalid_conversion_window",
+			fmt.Sprintf("conversion window should be between 1 and %d days", MaxFunnelConversionWindowDays),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	stepNames := make([]string, 0, len(req.Steps))
+	for _, step := range req.Steps {
+		stepNames = append(stepNames, step.Name)
+	}
+	conversionWindow := time.Duration(conversionWindowDays) * 24 * time.Hour
+	reachedStepCounts := make([]int, len(req.Steps))
+	secondsFromPreviousStep := make([][]float64, len(req.Steps))
+	appErr = scanOccurrencesByActor(useCase.eventRepository, repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Names:     stepNames,
+		From:      req.From,
+		To:        req.To,
+	}, func(actorOccurrences []*repository.EventOccurrence) {
+		stepTimestamps := findFunnelConversion(actorOccurrences, req.Steps, conversionWindow)
+		for stepIndex, stepTimestamp := range stepTimestamps {
+			reachedStepCounts[stepIndex]++
+			if stepIndex > 0 {
+				secondsFromPreviousStep[stepIndex] = append(
+					secondsFromPreviousStep[stepIndex],
+					stepTimestamp.Sub(stepTimestamps[stepIndex-1]).Seconds(),
+				)
+			}
+		}
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	response := &appmodel.GetProjectFunnelResponse{
+		ConversionWindowDays: conversionWindowDays,
+		Steps:                make([]*appmodel.FunnelStepResult, 0, len(req.Steps)),
+	}
+	for stepIndex, step := range req.Steps {
+		stepResult := &appmodel.FunnelStepResult{
+			Name:  step.Name,
+			Count: reachedStepCounts[stepIndex],
+		}
+		if stepIndex == 0 {
+			if stepResult.Count > 0 {
+				stepResult.ConversionRate = 1
+				stepResult.StepConversionRate = 1
+			}
+		} else {
+			stepResult.ConversionRate = rate(stepResult.Count, reachedStepCounts[0])
+			stepResult.StepConversionRate = rate(stepResult.Count, reachedStepCounts[stepIndex-1])
+			if len(secondsFromPreviousStep[stepIndex]) > 0 {
+				medianSeconds := median(secondsFromPreviousStep[stepIndex])
+				stepResult.MedianSecondsFromPreviousStep = &medianSeconds
+			}
+		}
+		response.Steps = append(response.Steps, stepResult)
+	}
+	return response, nil
+}
+
+func validateFunnelSteps(steps []*appmodel.FunnelStep) *appmodel.AppError {
+	if len(steps) < MinFunnelSteps || len(steps) > MaxFunnelSteps {
+		return appmodel.NewAppError(
+			"invalid_funnel_steps",
+			fmt.Sprintf("funnel should have between %d and %d steps", MinFunnelSteps, MaxFunnelSteps),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	for _, step := range steps {
+		if step == nil || step.Name == "" {
+			return appmodel.NewAppError("invalid_funnel_steps", "funnel step name is required", appmodel.ErrorTypeValidation)
+		}
+		for key := range step.Properties {
+			if !model.IsValidEventPropertyKey(key) {
+				return appmodel.NewAppError(
+					"invalid_property_filter",
+					fmt.Sprintf("invalid property key %q", key),
+					appmodel.ErrorTypeValidation,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// findFunnelConversion returns the timestamps of the steps reached by an end user, trying every occurrence
+// of the first step as the funnel start and keeping the one that goes further
+func findFunnelConversion(
+	occurrences []*repository.EventOccurrence,
+	steps []*appmodel.FunnelStep,
+	conversionWindow time.Duration,
+) []time.Time {
+	bestStepTimestamps := make([]time.Time, 0)
+	for startIndex, startOccurrence := range occurrences {
+		if !matchesFunnelStep(startOccurrence, steps[0]) {
+			continue
+		}
+
+		stepTimestamps := []time.Time{startOccurrence.Timestamp}
+		windowEnd := startOccurrence.Timestamp.Add(conversionWindow)
+		for _, occurrence := range occurrences[startIndex+1:] {
+			if len(stepTimestamps) == len(steps) || occurrence.Timestamp.After(windowEnd) {
+				break
+			}
+			if matchesFunnelStep(occurrence, steps[len(stepTimestamps)]) {
+				stepTimestamps = append(stepTimestamps, occurrence.Timestamp)
+			}
+		}
+
+		if len(stepTimestamps) > len(bestStepTimestamps) {
+			bestStepTimestamps = stepTimestamps
+		}
+		if len(bestStepTimestamps) == len(steps) {
+			break
+		}
+	}
+	return bestStepTimestamps
+}
+
+func matchesFunnelStep(occurrence *repository.EventOccurrence, step *appmodel.FunnelStep) bool {
+	if occurrence.Name != step.Name {
+		return false
+	}
+
+	for key, expectedValue := range step.Properties {
+		value, ok := occurrence.Properties[key]
+		if !ok || !reflect.DeepEqual(value, expectedValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// occurrences are read in pages, so only a page and an actor are held in memory at a time, and analyses stop once
+// they read too many of them, as the request would take too long anyway
+var (
+	eventOccurrencePageSize    = 10_000
+	maxScannedEventOccurrences = 2_000_000
+)
+
+// scanOccurrencesByActor visits the occurrences of each actor in turn, the visited slice is only valid during
+// the call
+func scanOccurrencesByActor(
+	eventRepository repository.EventRepository,
+	options repository.EventOccurrenceOptions,
+	visit func(actorOccurrences []*repository.EventOccurrence),
+) *appmodel.AppError {
+	options.Limit = eventOccurrencePageSize
+	scannedCount := 0
+	pending := []*repository.EventOccurrence{}
+	for {
+		page, err := eventRepository.ListOccurrences(options)
+		if err != nil {
+			return appmodel.NewAppError("unable_to_load_events", err.Error(), appmodel.ErrorTypeDatabase)
+		}
+
+		scannedCount += len(page)
+		if scannedCount > maxScannedEventOccurrences {
+			return appmodel.NewAppError(
+				"too_many_events",
+				fmt.Sprintf("time range should have at most %d matching events", maxScannedEventOccurrences),
+				appmodel.ErrorTypeValidation,
+			)
+		}
+
+		groups := groupOccurrencesByActor(append(pending, page...))
+		if len(page) < options.Limit {
+			for _, actorOccurrences := range groups {
+				visit(actorOccurrences)
+			}
+			return nil
+		}
+
+		// the last actor may go on in the next page
+		for _, actorOccurrences := range groups[:len(groups)-1] {
+			visit(actorOccurrences)
+		}
+		pending = append([]*repository.EventOccurrence{}, groups[len(groups)-1]...)
+		options.After = page[len(page)-1]
+	}
+}
+
+// groupOccurrencesByActor expects occurrences sorted by actor and timestamp
+func groupOccurrencesByActor(occurrences []*repository.EventOccurrence) [][]*repository.EventOccurrence {
+	groups := make([][]*repository.EventOccurrence, 0)
+	groupStart := 0
+	for index := range occurrences {
+		isLastOfActor := index == len(occurrences)-1 || occurrences[index+1].ActorID != occurrences[index].ActorID
+		if isLastOfActor {
+			groups = append(groups, occurrences[groupStart:index+1])
+			groupStart = index + 1
+		}
+	}
+	return groups
+}
+
+func rate(count int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+func median(values []float64) float64 {
+	sortedValues := append([]float64{}, values...)
+	sort.Float64s(sortedValues)
+
+	middle := len(sortedValues) / 2
+	if len(sortedValues)%2 == 0 {
+		return (sortedValues[middle-1] + sortedValues[middle]) / 2
+	}
+	return sortedValues[middle]
+}
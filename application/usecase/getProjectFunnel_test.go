@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectFunnelUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	eventRepositoryMock := repository.NewMockEventRepository(ctrl)
+	useCase := NewGetProjectFunnelUseCase(NewProjectPermissionService(projectMemberRepositoryMock), eventRepositoryMock)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := &model.GetProjectFunnelRequest{
+		Principal: model.Principal{UserID: "fake-actor-id"},
+		ProjectID: "fake-project-id",
+		Steps:     []*model.FunnelStep{{Name: "signup"}},
+		From:      from,
+		To:        from.AddDate(0, 1, 0),
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project stats")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_funnel_steps", err.(*model.AppError).Code)
+
+	req.Steps = []*model.FunnelStep{
+		{Name: "signup"},
+		{Name: "view_plan", Properties: map[string]any{"invalid key": "pro"}},
+	}
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_property_filter", err.(*model.AppError).Code)
+
+	req.Steps = []*model.FunnelStep{
+		{Name: "signup"},
+		{Name: "view_plan", Properties: map[string]any{"plan": "pro"}},
+		{Name: "purchase"},
+	}
+	req.ConversionWindowDays = MaxFunnelConversionWindowDays + 1
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_conversion_window", err.(*model.AppError).Code)
+
+	req.ConversionWindowDays = 0
+	expectedOptions := repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Names:     []string{"signup", "view_plan", "purchase"},
+		From:      req.From,
+		To:        req.To,
+		Limit:     eventOccurrencePageSize,
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_events]: unexpected error")
+
+	at := func(days int, hours int) time.Time {
+		return from.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+	}
+	proPlan := map[string]any{"plan": "pro"}
+	occurrences := []*repository.EventOccurrence{
+		// converts through all steps
+		{ActorID: "user-1", Name: "signup", Timestamp: at(0, 0)},
+		{ActorID: "user-1", Name: "view_plan", Timestamp: at(0, 1), Properties: proPlan},
+		{ActorID: "user-1", Name: "purchase", Timestamp: at(0, 3)},
+		// views a plan that doesn't match the step filter
+		{ActorID: "user-2", Name: "signup", Timestamp: at(1, 0)},
+		{ActorID: "user-2", Name: "view_plan", Timestamp: at(1, 2), Properties: map[string]any{"plan": "free"}},
+		{ActorID: "user-2", Name: "purchase", Timestamp: at(1, 3)},
+		// first attempt leaves the conversion window, second one converts
+		{ActorID: "user-3", Name: "signup", Timestamp: at(2, 0)},
+		{ActorID: "user-3", Name: "signup", Timestamp: at(10, 0)},
+		{ActorID: "user-3", Name: "view_plan", Timestamp: at(10, 3), Properties: proPlan},
+		{ActorID: "user-3", Name: "purchase", Timestamp: at(10, 5)},
+		// converts out of order
+		{ActorID: "user-4", Name: "view_plan", Timestamp: at(3, 0), Properties: proPlan},
+		{ActorID: "user-4", Name: "signup", Timestamp: at(3, 1)},
+		{ActorID: "user-4", Name: "view_plan", Timestamp: at(3, 2), Properties: proPlan},
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedOptions).
+		Return(occurrences, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, DefaultFunnelConversionWindowDays, res.ConversionWindowDays)
+	assert.Len(t, res.Steps, 3)
+	assertFunnelStepsCounts(t, res, []int{4, 3, 2})
+
+	assert.Equal(t, "signup", res.Steps[0].Name)
+	assert.Equal(t, 4, res.Steps[0].Count)
+	assert.Equal(t, 1.0, res.Steps[0].ConversionRate)
+	assert.Nil(t, res.Steps[0].MedianSecondsFromPreviousStep)
+
+	assert.Equal(t, "view_plan", res.Steps[1].Name)
+	assert.Equal(t, 3, res.Steps[1].Count)
+	assert.Equal(t, 0.75, res.Steps[1].ConversionRate)
+	assert.Equal(t, 0.75, res.Steps[1].StepConversionRate)
+	assert.Equal(t, time.Hour.Seconds(), *res.Steps[1].MedianSecondsFromPreviousStep)
+
+	assert.Equal(t, "purchase", res.Steps[2].Name)
+	assert.Equal(t, 2, res.Steps[2].Count)
+	assert.Equal(t, 0.5, res.Steps[2].ConversionRate)
+	assert.InDelta(t, 2.0/3.0, res.Steps[2].StepConversionRate, 0.0001)
+	assert.Equal(t, 2*time.Hour.Seconds(), *res.Steps[2].MedianSecondsFromPreviousStep)
+
+	// actors going on in the next page are only visited once they're complete
+	defer func(pageSize int, maxScanned int) {
+		eventOccurrencePageSize, maxScannedEventOccurrences = pageSize, maxScanned
+	}(eventOccurrencePageSize, maxScannedEventOccurrences)
+	eventOccurrencePageSize = 2
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(gomock.Any()).
+		Times(7).
+		DoAndReturn(pageEventOccurrences(occurrences))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assertFunnelStepsCounts(t, res, []int{4, 3, 2})
+
+	maxScannedEventOccurrences = 5
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(gomock.Any()).
+		Times(3).
+		DoAndReturn(pageEventOccurrences(occurrences))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "too_many_events", err.(*model.AppError).Code)
+}
+
+func assertFunnelStepsCounts(t *testing.T, res *model.GetProjectFunnelResponse, counts []int) {
+	stepsCounts := make([]int, 0, len(res.Steps))
+	for _, step := range res.Steps {
+		stepsCounts = append(stepsCounts, step.Count)
+	}
+	assert.Equal(t, counts, stepsCounts)
+}
+
+// pageEventOccurrences serves occurrences the way the repository pages them
+func pageEventOccurrences(
+	occurrences []*repository.EventOccurrence,
+) func(options repository.EventOccurrenceOptions) ([]*repository.EventOccurrence, error) {
+	return func(options repository.EventOccurrenceOptions) ([]*repository.EventOccurrence, error) {
+		start := 0
+		if options.After != nil {
+			start = slices.Index(occurrences, options.After) + 1
+		}
+		end := min(start+options.Limit, len(occurrences))
+		return occurrences[start:end], nil
+	}
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+const (
+	PathDirectionForward  = "forward"
+	PathDirectionBackward = "backward"
+	DefaultPathSteps      = 5
+	MaxPathSteps          = 10
+	DefaultPathsLimit     = 10
+	MaxPathsLimit         = 100
+)
+
+type GetProjectPathsUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	eventRepository          repository.EventRepository
+}
+
+func NewGetProjectPathsUseCase(
+	projectPermissionService *ProjectPermissionService,
+	eventRepository repository.EventRepository,
+) *GetProjectPathsUseCase {
+	return &GetProjectPathsUseCase{projectPermissionService, eventRepository}
+}
+
+func (useCase *GetProjectPathsUseCase) Execute(
+	req *appmodel.GetProjectPathsRequest,
+) (*appmodel.GetProjectPathsResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	steps := req.Steps
+	if steps == 0 {
+		steps = DefaultPathSteps
+	}
+	if steps < 0 || steps > MaxPathSteps {
+		return nil, appmodel.NewAppError(
+			"invalid_path_steps",
+			fmt.Sprintf("steps should be between 1 and %d", MaxPathSteps),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultPathsLimit
+	}
+	if limit < 0 || limit > MaxPathsLimit {
+		return nil, appmodel.NewAppError(
+			"invalid_limit",
+			fmt.Sprintf("limit should be between 1 and %d", MaxPathsLimit),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	if req.SessionTimeoutMinutes < 0 {
+		return nil, appmodel.NewAppError(
+			"invalid_session_timeout",
+			"session timeout should not be negative",
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	sessionTimeout := time.Duration(req.SessionTimeoutMinutes) * time.Minute
+	pathCounts := map[string]int{}
+	nodes := map[string]*appmodel.PathNode{}
+	edges := map[string]*appmodel.PathEdge{}
+	appErr = scanOccurrencesByActor(useCase.eventRepository, repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		From:      req.From,
+		To:        req.To,
+	}, func(actorOccurrences []*repository.EventOccurrence) {
+		path := findPath(actorOccurrences, req, steps, sessionTimeout)
+		if len(path) == 0 {
+			return
+		}
+		pathCounts[strings.Join(path, "\x00")]++
+
+		// steps are counted from the anchor event, backward paths end on it
+		firstStep := 0
+		if req.Direction == PathDirectionBackward {
+			firstStep = 1 - len(path)
+		}
+		previousNodeID := ""
+		for index, name := range path {
+			step := firstStep + index
+			nodeID := fmt.Sprintf("%d:%s", step, name)
+			if nodes[nodeID] == nil {
+				nodes[nodeID] = &appmodel.PathNode{ID: nodeID, Step: step, Name: name}
+			}
+			nodes[nodeID].Count++
+
+			if previousNodeID != "" {
+				edgeID := previousNodeID + "\x00" + nodeID
+				if edges[edgeID] == nil {
+					edges[edgeID] = &appmodel.PathEdge{Source: previousNodeID, Target: nodeID}
+				}
+				edges[edgeID].Count++
+			}
+			previousNodeID = nodeID
+		}
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	response := &appmodel.GetProjectPathsResponse{
+		Direction: req.Direction,
+		Nodes:     make([]*appmodel.PathNode, 0, len(nodes)),
+		Edges:     make([]*appmodel.PathEdge, 0, len(edges)),
+		Paths:     make([]*appmodel.Path, 0, len(pathCounts)),
+	}
+	for _, node := range nodes {
+		response.Nodes = append(response.Nodes, node)
+	}
+	sort.Slice(response.Nodes, func(i, j int) bool {
+		first, second := response.Nodes[i], response.Nodes[j]
+		if first.Step != second.Step {
+			return first.Step < second.Step
+		}
+		if first.Count != second.Count {
+			return first.Count > second.Count
+		}
+		return first.Name < second.Name
+	})
+
+	for _, edge := range edges {
+		response.Edges = append(response.Edges, edge)
+	}
+	sort.Slice(response.Edges, func(i, j int) bool {
+		first, second := response.Edges[i], response.Edges[j]
+		if first.Count != second.Count {
+			return first.Count > second.Count
+		}
+		if first.Source != second.Source {
+			return first.Source < second.Source
+		}
+		return first.Target < second.Target
+	})
+
+	for path, count := range pathCounts {
+		response.Paths = append(response.Paths, &appmodel.Path{Events: strings.Split(path, "\x00"), Count: count})
+	}
+	sort.Slice(response.Paths, func(i, j int) bool {
+		first, second := response.Paths[i], response.Paths[j]
+		if first.Count != second.Count {
+			return first.Count > second.Count
+		}
+		return strings.Join(first.Events, "\x00") < strings.Join(second.Events, "\x00")
+	})
+	if len(response.Paths) > limit {
+		response.Paths = response.Paths[:limit]
+	}
+	return response, nil
+}
+
+// findPath returns the events an end user performed after its first occurrence of the anchor event, or before
+// its last one when going backward, in chronological order and including the anchor event itself.
+// The path stops at the first gap longer than the session timeout, when it is set.
+func findPath(
+	occurrences []*repository.EventOccurrence,
+	req *appmodel.GetProjectPathsRequest,
+	steps int,
+	sessionTimeout time.Duration,
+) []string {
+	anchorIndex, direction := -1, 1
+	for index, occurrence := range occurrences {
+		if occurrence.Name == req.Event {
+			anchorIndex = index
+			if req.Direction == PathDirectionForward {
+				break
+			}
+		}
+	}
+	if anchorIndex == -1 {
+		return nil
+	}
+	if req.Direction == PathDirectionBackward {
+		direction = -1
+	}
+
+	path := []string{req.Event}
+	previousTimestamp := occurrences[anchorIndex].Timestamp
+	for index := anchorIndex + direction; index >= 0 && index < len(occurrences) && len(path) <= steps; index += direction {
+		occurrence := occurrences[index]
+		gap := occurrence.Timestamp.Sub(previousTimestamp)
+		if gap < 0 {
+			gap = -gap
+		}
+		if sessionTimeout > 0 && gap > sessionTimeout {
+			break
+		}
+		previousTimestamp = occurrence.Timestamp
+
+		if req.CollapseRepeated && path[len(path)-1] == occurrence.Name {
+			continue
+		}
+		path = append(path, occurrence.Name)
+	}
+
+	if req.Direction == PathDirectionBackward {
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+	}
+	return path
+}
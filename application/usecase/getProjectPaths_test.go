@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectPathsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	eventRepositoryMock := repository.NewMockEventRepository(ctrl)
+	useCase := NewGetProjectPathsUseCase(NewProjectPermissionService(projectMemberRepositoryMock), eventRepositoryMock)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := &model.GetProjectPathsRequest{
+		Principal:             model.Principal{UserID: "fake-actor-id"},
+		ProjectID:             "fake-project-id",
+		Event:                 "signup",
+		Direction:             PathDirectionForward,
+		Steps:                 MaxPathSteps + 1,
+		SessionTimeoutMinutes: 30,
+		From:                  from,
+		To:                    from.AddDate(0, 1, 0),
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project stats")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_path_steps", err.(*model.AppError).Code)
+
+	req.Steps = 3
+	req.Limit = MaxPathsLimit + 1
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_limit", err.(*model.AppError).Code)
+
+	req.Limit = 0
+	expectedOptions := repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		From:      req.From,
+		To:        req.To,
+		Limit:     eventOccurrencePageSize,
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_events]: unexpected error")
+
+	at := func(hour int, minute int) time.Time {
+		return from.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedOptions).
+		AnyTimes().
+		Return([]*repository.EventOccurrence{
+			{ActorID: "user-1", Name: "home", Timestamp: at(10, 0)},
+			{ActorID: "user-1", Name: "signup", Timestamp: at(10, 5)},
+			{ActorID: "user-1", Name: "signup", Timestamp: at(10, 6)},
+			{ActorID: "user-1", Name: "pricing", Timestamp: at(10, 10)},
+			{ActorID: "user-1", Name: "purchase", Timestamp: at(10, 20)},
+			{ActorID: "user-1", Name: "logout", Timestamp: at(10, 25)},
+			// leaves for longer than the session timeout before purchasing
+			{ActorID: "user-2", Name: "signup", Timestamp: at(11, 0)},
+			{ActorID: "user-2", Name: "pricing", Timestamp: at(11, 10)},
+			{ActorID: "user-2", Name: "purchase", Timestamp: at(13, 0)},
+			// never signs up
+			{ActorID: "user-3", Name: "home", Timestamp: at(9, 0)},
+			{ActorID: "user-4", Name: "signup", Timestamp: at(9, 0)},
+			{ActorID: "user-4", Name: "pricing", Timestamp: at(9, 1)},
+			{ActorID: "user-4", Name: "purchase", Timestamp: at(9, 2)},
+			{ActorID: "user-4", Name: "logout", Timestamp: at(9, 3)},
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Paths, 3)
+	assert.Equal(t, []string{"signup", "pricing"}, res.Paths[0].Events)
+	assert.Equal(t, 1, res.Paths[0].Count)
+	assert.Equal(t, []string{"signup", "pricing", "purchase", "logout"}, res.Paths[1].Events)
+	assert.Equal(t, []string{"signup", "signup", "pricing", "purchase"}, res.Paths[2].Events)
+
+	req.CollapseRepeated = true
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Paths, 2)
+	assert.Equal(t, []string{"signup", "pricing", "purchase", "logout"}, res.Paths[0].Events)
+	assert.Equal(t, 2, res.Paths[0].Count)
+	assert.Equal(t, []string{"signup", "pricing"}, res.Paths[1].Events)
+	assert.Equal(t, 1, res.Paths[1].Count)
+
+	assert.Len(t, res.Nodes, 4)
+	assert.Equal(t, model.PathNode{ID: "0:signup", Step: 0, Name: "signup", Count: 3}, *res.Nodes[0])
+	assert.Equal(t, model.PathNode{ID: "1:pricing", Step: 1, Name: "pricing", Count: 3}, *res.Nodes[1])
+	assert.Equal(t, model.PathNode{ID: "3:logout", Step: 3, Name: "logout", Count: 2}, *res.Nodes[3])
+	assert.Len(t, res.Edges, 3)
+	assert.Equal(t, model.PathEdge{Source: "0:signup", Target: "1:pricing", Count: 3}, *res.Edges[0])
+
+	req.Event = "purchase"
+	req.Direction = PathDirectionBackward
+	req.Steps = 2
+	req.Limit = 1
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Paths, 1)
+	assert.Equal(t, []string{"signup", "pricing", "purchase"}, res.Paths[0].Events)
+	assert.Equal(t, 2, res.Paths[0].Count)
+	assert.Equal(t, model.PathNode{ID: "-2:signup", Step: -2, Name: "signup", Count: 2}, *res.Nodes[0])
+	assert.Equal(t, model.PathNode{ID: "0:purchase", Step: 0, Name: "purchase", Count: 3}, *res.Nodes[len(res.Nodes)-1])
+}
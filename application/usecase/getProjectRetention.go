@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+const (
+	RetentionTypeNDay       = "n_day"
+	RetentionTypeUnbounded  = "unbounded"
+	DefaultRetentionPeriods = 8
+	MaxRetentionPeriods     = 90
+	MaxRetentionCohorts     = 366
+)
+
+type GetProjectRetentionUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	eventRepository          repository.EventRepository
+}
+
+func NewGetProjectRetentionUseCase(
+	projectPermissionService *ProjectPermissionService,
+	eventRepository repository.EventRepository,
+) *GetProjectRetentionUseCase {
+	return &GetProjectRetentionUseCase{projectPermissionService, eventRepository}
+}
+
+func (useCase *GetProjectRetentionUseCase) Execute(
+	req *appmodel.GetProjectRetentionRequest,
+) (*appmodel.GetProjectRetentionResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	location, appErr := loadTimezone(req.Timezone)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	periods := req.Periods
+	if periods == 0 {
+		periods = DefaultRetentionPeriods
+	}
+	if periods < 0 || periods > MaxRetentionPeriods {
+		return nil, appmodel.NewAppError(
+			"invalid_retention_periods",
+			fmt.Sprintf("periods should be between 1 and %d", MaxRetentionPeriods),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	cohortStarts := make([]time.Time, 0)
+	cohortStart := truncateToInterval(req.From.In(location), req.Period)
+	for cohortStart.Before(req.To) {
+		if len(cohortStarts) == MaxRetentionCohorts {
+			return nil, appmodel.NewAppError(
+				"too_many_cohorts",
+				fmt.Sprintf("time range should have at most %d cohorts", MaxRetentionCohorts),
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		cohortStarts = append(cohortStarts, cohortStart)
+		cohortStart = addIntervals(cohortStart, req.Period, 1)
+	}
+
+	// end users belong to the cohort of the first start event they ever performed, those who performed it before
+	// the requested range aren't new in it
+	startOccurrences, err := useCase.eventRepository.ListFirstOccurrences(repository.EventFirstOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Name:      req.StartEvent,
+		From:      req.From,
+		To:        req.To,
+	})
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_load_events", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	cohortSizes := make(map[int64]int, len(cohortStarts))
+	retainedCounts := make(map[int64][]int, len(cohortStarts))
+	startOccurrencesByActor := make(map[string]*repository.EventOccurrence, len(startOccurrences))
+	for _, startOccurrence := range startOccurrences {
+		startOccurrencesByActor[startOccurrence.ActorID] = startOccurrence
+		cohortKey := truncateToInterval(startOccurrence.Timestamp.In(location), req.Period).Unix()
+		cohortSizes[cohortKey]++
+		if retainedCounts[cohortKey] == nil {
+			retainedCounts[cohortKey] = make([]int, periods+1)
+		}
+	}
+
+	appErr = scanOccurrencesByActor(useCase.eventRepository, repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Names:     []string{req.ReturnEvent},
+		From:      req.From,
+		To:        addIntervals(cohortStarts[len(cohortStarts)-1], req.Period, periods+1),
+	}, func(actorOccurrences []*repository.EventOccurrence) {
+		startOccurrence := startOccurrencesByActor[actorOccurrences[0].ActorID]
+		if startOccurrence == nil {
+			return
+		}
+		actorCohortStart, returnedPeriods := findRetention(startOccurrence, actorOccurrences, req, location, periods)
+		cohortKey := actorCohortStart.Unix()
+
+		lastReturnedPeriod := 0
+		for period := 1; period <= periods; period++ {
+			if returnedPeriods[period] {
+				lastReturnedPeriod = period
+				if req.Type == RetentionTypeNDay {
+					retainedCounts[cohortKey][period]++
+				}
+			}
+		}
+		// with unbounded retention, returning in a period also counts for all periods before it
+		if req.Type == RetentionTypeUnbounded {
+			for period := 1; period <= lastReturnedPeriod; period++ {
+				retainedCounts[cohortKey][period]++
+			}
+		}
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	now := time.Now()
+	response := &appmodel.GetProjectRetentionResponse{
+		Period:   req.Period,
+		Type:     req.Type,
+		Timezone: location.String(),
+		Cohorts:  make([]*appmodel.RetentionCohort, 0, len(cohortStarts)),
+	}
+	for _, cohortStart := range cohortStarts {
+		cohortKey := cohortStart.Unix()
+		cohort := &appmodel.RetentionCohort{
+			Start:   cohortStart,
+			Size:    cohortSizes[cohortKey],
+			Periods: make([]*appmodel.RetentionPeriod, 0, periods+1),
+		}
+		// periods that didn't start yet are left out, so the cohorts form a triangle
+		for period := 0; period <= periods && addIntervals(cohortStart, req.Period, period).Before(now); period++ {
+			count := cohort.Size
+			if period > 0 {
+				count = 0
+				if retainedCounts[cohortKey] != nil {
+					count = retainedCounts[cohortKey][period]
+				}
+			}
+			cohort.Periods = append(cohort.Periods, &appmodel.RetentionPeriod{
+				Index: period,
+				Count: count,
+				Rate:  rate(count, cohort.Size),
+			})
+		}
+		response.Cohorts = append(response.Cohorts, cohort)
+	}
+	return response, nil
+}
+
+// findRetention returns the cohort of an end user, given by its first start event, and the periods after it
+// in which the end user performed the return event
+func findRetention(
+	startOccurrence *repository.EventOccurrence,
+	returnOccurrences []*repository.EventOccurrence,
+	req *appmodel.GetProjectRetentionRequest,
+	location *time.Location,
+	periods int,
+) (time.Time, map[int]bool) {
+	cohortStart := truncateToInterval(startOccurrence.Timestamp.In(location), req.Period)
+	returnedPeriods := map[int]bool{}
+	for _, occurrence := range returnOccurrences {
+		if !occurrence.Timestamp.After(startOccurrence.Timestamp) {
+			continue
+		}
+		period := intervalsBetween(
+			cohortStart,
+			truncateToInterval(occurrence.Timestamp.In(location), req.Period),
+			req.Period,
+		)
+		if period >= 1 && period <= periods {
+			returnedPeriods[period] = true
+		}
+	}
+	return cohortStart, returnedPeriods
+}
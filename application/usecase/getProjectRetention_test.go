@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectRetentionUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	eventRepositoryMock := repository.NewMockEventRepository(ctrl)
+	useCase := NewGetProjectRetentionUseCase(NewProjectPermissionService(projectMemberRepositoryMock), eventRepositoryMock)
+
+	// monday
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	req := &model.GetProjectRetentionRequest{
+		Principal:   model.Principal{UserID: "fake-actor-id"},
+		ProjectID:   "fake-project-id",
+		StartEvent:  "signup",
+		ReturnEvent: "login",
+		Period:      TimeIntervalWeek,
+		Type:        RetentionTypeNDay,
+		Periods:     3,
+		From:        from,
+		To:          from.AddDate(0, 0, 14),
+		Timezone:    "invalid",
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project stats")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_timezone", err.(*model.AppError).Code)
+
+	req.Timezone = ""
+	req.Periods = MaxRetentionPeriods + 1
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_retention_periods", err.(*model.AppError).Code)
+
+	req.Periods = 3
+	req.Period = TimeIntervalDay
+	req.From = from.AddDate(-2, 0, 0)
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "too_many_cohorts", err.(*model.AppError).Code)
+
+	req.Period = TimeIntervalWeek
+	req.From = from
+	expectedFirstOptions := repository.EventFirstOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Name:      "signup",
+		From:      req.From,
+		To:        req.To,
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListFirstOccurrences(expectedFirstOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_events]: unexpected error")
+
+	at := func(day int) time.Time {
+		return time.Date(2024, 3, day, 12, 0, 0, 0, time.UTC)
+	}
+	// end users who signed up before the range aren't listed, so they don't belong to any cohort
+	startOccurrences := []*repository.EventOccurrence{
+		{ActorID: "user-1", Name: "signup", Timestamp: at(5)},
+		{ActorID: "user-2", Name: "signup", Timestamp: at(6)},
+		{ActorID: "user-3", Name: "signup", Timestamp: at(12)},
+	}
+	expectedReturnOptions := repository.EventOccurrenceOptions{
+		ProjectID: req.ProjectID,
+		Names:     []string{"login"},
+		From:      req.From,
+		To:        time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC),
+		Limit:     eventOccurrencePageSize,
+	}
+	returnOccurrences := []*repository.EventOccurrence{
+		// returns in the first and third weeks after signing up
+		{ActorID: "user-1", Name: "login", Timestamp: at(6)},
+		{ActorID: "user-1", Name: "login", Timestamp: at(13)},
+		{ActorID: "user-1", Name: "login", Timestamp: at(27)},
+		// returns in the second week after signing up
+		{ActorID: "user-2", Name: "login", Timestamp: at(20)},
+		// logs in before signing up, which doesn't count
+		{ActorID: "user-3", Name: "login", Timestamp: at(4)},
+		{ActorID: "user-3", Name: "login", Timestamp: at(19)},
+		// never signs up
+		{ActorID: "user-4", Name: "login", Timestamp: at(20)},
+	}
+	eventRepositoryMock.
+		EXPECT().
+		ListFirstOccurrences(expectedFirstOptions).
+		Return(startOccurrences, nil)
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedReturnOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_events]: unexpected error")
+
+	eventRepositoryMock.
+		EXPECT().
+		ListFirstOccurrences(expectedFirstOptions).
+		Times(2).
+		Return(startOccurrences, nil)
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(expectedReturnOptions).
+		Times(2).
+		Return(returnOccurrences, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "UTC", res.Timezone)
+	assert.Len(t, res.Cohorts, 2)
+
+	assert.Equal(t, from, res.Cohorts[0].Start)
+	assert.Equal(t, 2, res.Cohorts[0].Size)
+	assert.Len(t, res.Cohorts[0].Periods, 4)
+	assert.Equal(t, []int{2, 1, 1, 1}, retentionCounts(res.Cohorts[0]))
+	assert.Equal(t, 1.0, res.Cohorts[0].Periods[0].Rate)
+	assert.Equal(t, 0.5, res.Cohorts[0].Periods[1].Rate)
+
+	assert.Equal(t, from.AddDate(0, 0, 7), res.Cohorts[1].Start)
+	assert.Equal(t, 1, res.Cohorts[1].Size)
+	assert.Equal(t, []int{1, 1, 0, 0}, retentionCounts(res.Cohorts[1]))
+
+	req.Type = RetentionTypeUnbounded
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []int{2, 2, 2, 1}, retentionCounts(res.Cohorts[0]))
+	assert.Equal(t, []int{1, 1, 0, 0}, retentionCounts(res.Cohorts[1]))
+
+	today := truncateToInterval(time.Now().UTC(), TimeIntervalDay)
+	req.Period = TimeIntervalDay
+	req.From = today.AddDate(0, 0, -2)
+	req.To = today.Add(time.Hour)
+	eventRepositoryMock.
+		EXPECT().
+		ListFirstOccurrences(gomock.Any()).
+		Return([]*repository.EventOccurrence{}, nil)
+	eventRepositoryMock.
+		EXPECT().
+		ListOccurrences(gomock.Any()).
+		Return([]*repository.EventOccurrence{}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Cohorts, 3)
+	assert.Len(t, res.Cohorts[0].Periods, 3)
+	assert.Len(t, res.Cohorts[1].Periods, 2)
+	assert.Len(t, res.Cohorts[2].Periods, 1)
+	assert.Equal(t, 0.0, res.Cohorts[0].Periods[0].Rate)
+}
+
+func retentionCounts(cohort *model.RetentionCohort) []int {
+	counts := make([]int, 0, len(cohort.Periods))
+	for _, period := range cohort.Periods {
+		counts = append(counts, period.Count)
+	}
+	return counts
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type GetProjectSessionStatsUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	endUserSessionRepository repository.EndUserSessionRepository
+}
+
+func NewGetProjectSessionStatsUseCase(
+	projectPermissionService *ProjectPermissionService,
+	endUserSessionRepository repository.EndUserSessionRepository,
+) *GetProjectSessionStatsUseCase {
+	return &GetProjectSessionStatsUseCase{projectPermissionService, endUserSessionRepository}
+}
+
+func (useCase *GetProjectSessionStatsUseCase) Execute(
+	req *appmodel.GetProjectSessionStatsRequest,
+) (*appmodel.GetProjectSessionStatsResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	aggregate, err := useCase.endUserSessionRepository.Aggregate(repository.EndUserSessionListOptions{
+		ProjectID: req.ProjectID,
+		From:      req.From,
+		To:        req.To,
+	})
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_load_stats", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	// sessions with a single event are bounces
+	return &appmodel.GetProjectSessionStatsResponse{
+		SessionsCount:          aggregate.SessionsCount,
+		AverageDurationSeconds: aggregate.AverageDurationSeconds,
+		AverageEventCount:      aggregate.AverageEventCount,
+		BounceRate:             rate(aggregate.BouncedSessionsCount, aggregate.SessionsCount),
+	}, nil
+}
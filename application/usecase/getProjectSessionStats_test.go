@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectSessionStatsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	endUserSessionRepositoryMock := repository.NewMockEndUserSessionRepository(ctrl)
+	useCase := NewGetProjectSessionStatsUseCase(NewProjectPermissionService(projectMemberRepositoryMock), endUserSessionRepositoryMock)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := &model.GetProjectSessionStatsRequest{
+		Principal: model.Principal{UserID: "fake-actor-id"},
+		ProjectID: "fake-project-id",
+		From:      from,
+		To:        from.AddDate(0, 1, 0),
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project stats")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+	expectedOptions := repository.EndUserSessionListOptions{ProjectID: req.ProjectID, From: req.From, To: req.To}
+	endUserSessionRepositoryMock.
+		EXPECT().
+		Aggregate(expectedOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_load_stats]: unexpected error")
+
+	endUserSessionRepositoryMock.
+		EXPECT().
+		Aggregate(expectedOptions).
+		Return(&repository.EndUserSessionAggregate{
+			SessionsCount:          4,
+			BouncedSessionsCount:   1,
+			AverageDurationSeconds: 95.5,
+			AverageEventCount:      3.25,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 4, res.SessionsCount)
+	assert.Equal(t, 95.5, res.AverageDurationSeconds)
+	assert.Equal(t, 3.25, res.AverageEventCount)
+	assert.Equal(t, 0.25, res.BounceRate)
+}
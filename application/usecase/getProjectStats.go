@@ -6,27 +6,23 @@ import (
 )
 
 type GetProjectStatsUseCase struct {
-	projectRepository repository.ProjectRepository
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
 }
 
-func NewGetProjectStatsUseCase(projectRepository repository.ProjectRepository) *GetProjectStatsUseCase {
-	return &GetProjectStatsUseCase{projectRepository}
+func NewGetProjectStatsUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+) *GetProjectStatsUseCase {
+	return &GetProjectStatsUseCase{projectPermissionService, projectRepository}
 }
 
 func (useCase *GetProjectStatsUseCase) Execute(
 	req *appmodel.GetProjectStatsRequest,
 ) (*appmodel.GetProjectStatsResponse, error) {
-	isMember, err := useCase.projectRepository.HasMember(req.ProjectID, req.ActorID)
-	if err != nil {
-		return nil, appmodel.NewAppError("unable_to_check_membership", err.Error(), appmodel.ErrorTypeDatabase)
-	}
-
-	if !isMember {
-		return nil, appmodel.NewAppError(
-			"not_project_member",
-			"only project members can see project details",
-			appmodel.ErrorTypeValidation,
-		)
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
 	}
 
 	stats, err := useCase.projectRepository.FindMembersCountAndEventsCountById(req.ProjectID)
@@ -4,46 +4,53 @@ import (
 	"errors"
 	"github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 	"testing"
 )
 
 func TestGetProjectStatsUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
-	useCase := NewGetProjectStatsUseCase(projectRepositoryMock)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewGetProjectStatsUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectRepositoryMock)
 
 	req := &model.GetProjectStatsRequest{
-		ActorID:   "fake-actor-id",
+		Principal: model.Principal{UserID: "fake-actor-id"},
 		ProjectID: "fake-project-id",
 	}
 
-	projectRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
-		Return(false, errors.New("unexpected error"))
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
 
 	res, err := useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_check_membership] unexpected error")
 
-	projectRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
-		Return(false, nil)
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [not_project_member] only project members can see project details")
 
-	projectRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
 		AnyTimes().
-		Return(true, nil)
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
 	projectRepositoryMock.
 		EXPECT().
 		FindMembersCountAndEventsCountById(req.ProjectID).
@@ -67,4 +74,22 @@ func TestGetProjectStatsUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Equal(t, 10, res.InvitesCount)
 	assert.Equal(t, 20, res.EventsCount)
+
+	// read api keys are authorized on their own project without any membership
+	req.Principal = model.Principal{ApiKeyID: "fake-api-key-id", ApiKeyProjectID: "fake-other-project-id"}
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "api_key_not_allowed", err.(*model.AppError).Code)
+
+	req.Principal.ApiKeyProjectID = req.ProjectID
+	projectRepositoryMock.
+		EXPECT().
+		FindMembersCountAndEventsCountById(req.ProjectID).
+		Return(&repository.ProjectInvitesCountAndEventsCount{EventsCount: 20}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 20, res.EventsCount)
 }
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+type IdentifyEndUserUseCase struct {
+	projectApiKeyRepository      repository.ProjectApiKeyRepository
+	ingestionSignatureRepository repository.IngestionSignatureRepository
+	endUserIdentityRepository    repository.EndUserIdentityRepository
+}
+
+func NewIdentifyEndUserUseCase(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+	ingestionSignatureRepository repository.IngestionSignatureRepository,
+	endUserIdentityRepository repository.EndUserIdentityRepository,
+) *IdentifyEndUserUseCase {
+	return &IdentifyEndUserUseCase{projectApiKeyRepository, ingestionSignatureRepository, endUserIdentityRepository}
+}
+
+func (useCase *IdentifyEndUserUseCase) Execute(req *appmodel.IdentifyEndUserRequest) error {
+	receivedAt := time.Now()
+	apiKey, appErr := authenticateProjectApiKey(useCase.projectApiKeyRepository, req.ApiKey, model.ProjectApiKeyScopeIngest)
+	if appErr != nil {
+		return appErr
+	}
+
+	appErr = verifyIngestionOrigin(apiKey.Project, req.Origin)
+	if appErr != nil {
+		return appErr
+	}
+
+	appErr = verifyIngestionSignature(useCase.ingestionSignatureRepository, apiKey, req.Signature, receivedAt)
+	if appErr != nil {
+		return appErr
+	}
+	project := apiKey.Project
+
+	identity, err := model.NewEndUserIdentity(project, req.AnonymousID, req.DistinctID)
+	if err != nil {
+		return appmodel.NewAppError("invalid_data_to_identify_end_user", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.endUserIdentityRepository.Register(identity)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_identify_end_user", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	storedIdentity, err := useCase.endUserIdentityRepository.FindByAnonymousID(project.ID, req.AnonymousID)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_identify_end_user", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if storedIdentity.DistinctID != req.DistinctID {
+		return appmodel.NewAppError(
+			"anonymous_id_already_identified",
+			"anonymous id was already identified as another distinct id",
+			appmodel.ErrorTypeValidation,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestIdentifyEndUserUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProjectApiKeyRepository := repository.NewMockProjectApiKeyRepository(ctrl)
+	mockEndUserIdentityRepository := repository.NewMockEndUserIdentityRepository(ctrl)
+	mockIngestionSignatureRepository := repository.NewMockIngestionSignatureRepository(ctrl)
+	useCase := NewIdentifyEndUserUseCase(
+		mockProjectApiKeyRepository,
+		mockIngestionSignatureRepository,
+		mockEndUserIdentityRepository,
+	)
+
+	req := &appmodel.IdentifyEndUserRequest{
+		ApiKey:      "fake-api-key",
+		AnonymousID: "user-1",
+		DistinctID:  "user-1",
+	}
+
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	apiKey.MarkUsed(time.Now())
+	project := apiKey.Project
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		AnyTimes().
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_data_to_identify_end_user", err.(*appmodel.AppError).Code)
+
+	req.AnonymousID = "anonymous-1"
+	mockEndUserIdentityRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_identify_end_user]: unexpected error")
+
+	mockEndUserIdentityRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		AnyTimes().
+		Return(nil)
+	mockEndUserIdentityRepository.
+		EXPECT().
+		FindByAnonymousID(project.ID, req.AnonymousID).
+		Return(&domainmodel.EndUserIdentity{AnonymousID: req.AnonymousID, DistinctID: "user-2"}, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "anonymous_id_already_identified", err.(*appmodel.AppError).Code)
+
+	mockEndUserIdentityRepository.
+		EXPECT().
+		FindByAnonymousID(project.ID, req.AnonymousID).
+		Return(&domainmodel.EndUserIdentity{AnonymousID: req.AnonymousID, DistinctID: req.DistinctID}, nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// verifyIngestionOrigin only restricts browser requests, server-side callers don't send an origin
+func verifyIngestionOrigin(project *model.Project, origin string) *appmodel.AppError {
+	if origin == "" || project.AllowsOrigin(origin) {
+		return nil
+	}
+
+	return appmodel.NewAppError(
+		"origin_not_allowed",
+		"origin is not allowed to send events to the project",
+		appmodel.ErrorTypeForbidden,
+	)
+}
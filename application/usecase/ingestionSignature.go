@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+// verifyIngestionSignature rejects unsigned requests when the project enforces signing, unless the api key
+// allows unsigned ingestion, and rejects signed requests that are invalid, expired or replayed
+func verifyIngestionSignature(
+	ingestionSignatureRepository repository.IngestionSignatureRepository,
+	apiKey *model.ProjectApiKey,
+	signature *appmodel.RequestSignature,
+	receivedAt time.Time,
+) *appmodel.AppError {
+	project := apiKey.Project
+	if signature == nil {
+		if project.RequireSignedIngestion && !apiKey.AllowUnsigned {
+			return appmodel.NewAppError(
+				"signature_required",
+				"project requires signed ingestion requests",
+				appmodel.ErrorTypeAuthentication,
+			)
+		}
+		return nil
+	}
+
+	unixTimestamp, err := strconv.ParseInt(signature.Timestamp, 10, 64)
+	if err != nil {
+		return appmodel.NewAppError("invalid_signature", "invalid signature timestamp", appmodel.ErrorTypeAuthentication)
+	}
+
+	tolerance := config.GetAppConfig().EventSignatureTolerance
+	signedAt := time.Unix(unixTimestamp, 0)
+	if signedAt.Before(receivedAt.Add(-tolerance)) || signedAt.After(receivedAt.Add(tolerance)) {
+		return appmodel.NewAppError(
+			"expired_signature",
+			"signature timestamp is outside the accepted tolerance",
+			appmodel.ErrorTypeAuthentication,
+		)
+	}
+
+	if !project.VerifyIngestionSignature(signature.Timestamp, signature.Body, signature.Signature) {
+		return appmodel.NewAppError("invalid_signature", "invalid signature", appmodel.ErrorTypeAuthentication)
+	}
+
+	// signatures outside the tolerance are already rejected, so there's no need to keep them to detect replays
+	err = ingestionSignatureRepository.DeleteSignedBefore(project.ID, receivedAt.Add(-tolerance))
+	if err != nil {
+		slog.Error("Unable to delete expired ingestion signatures", "error", err)
+	}
+
+	ingestionSignature, err := model.NewIngestionSignature(project, signature.Signature, signedAt)
+	if err != nil {
+		return appmodel.NewAppError("invalid_signature", err.Error(), appmodel.ErrorTypeAuthentication)
+	}
+
+	err = ingestionSignatureRepository.Register(ingestionSignature)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return appmodel.NewAppError(
+				"replayed_request",
+				"signed request was already received",
+				appmodel.ErrorTypeAuthentication,
+			)
+		}
+		return appmodel.NewAppError("unable_to_verify_signature", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestVerifyIngestionSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIngestionSignatureRepository := repository.NewMockIngestionSignatureRepository(ctrl)
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	project := apiKey.Project
+	receivedAt := time.Now()
+
+	appErr := verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, nil, receivedAt)
+	assert.Nil(t, appErr)
+
+	_, _ = project.RotateSigningSecret()
+	_ = project.ChangeSignedIngestionRequirement(true)
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, nil, receivedAt)
+	assert.NotNil(t, appErr)
+	assert.Equal(t, "signature_required", appErr.Code)
+
+	browserApiKey, _, _ := domainmodel.NewProjectApiKey(project, "browser", domainmodel.ProjectApiKeyScopeIngest, project.Memberships[0].User)
+	_ = browserApiKey.AllowUnsignedIngestion()
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, browserApiKey, nil, receivedAt)
+	assert.Nil(t, appErr)
+
+	body := []byte(`{"name":"signup"}`)
+	sign := func(signedAt time.Time) *appmodel.RequestSignature {
+		timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+		signature, _ := project.SignIngestionPayload(timestamp, body)
+		return &appmodel.RequestSignature{Signature: signature, Timestamp: timestamp, Body: body}
+	}
+
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, sign(receivedAt.Add(-time.Hour)), receivedAt)
+	assert.NotNil(t, appErr)
+	assert.Equal(t, "expired_signature", appErr.Code)
+
+	tamperedSignature := sign(receivedAt)
+	tamperedSignature.Body = []byte(`{"name":"purchase"}`)
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, tamperedSignature, receivedAt)
+	assert.NotNil(t, appErr)
+	assert.Equal(t, "invalid_signature", appErr.Code)
+
+	signature := sign(receivedAt)
+	mockIngestionSignatureRepository.
+		EXPECT().
+		DeleteSignedBefore(project.ID, receivedAt.Add(-5*time.Minute)).
+		AnyTimes().
+		Return(nil)
+	mockIngestionSignatureRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		DoAndReturn(func(ingestionSignature *domainmodel.IngestionSignature) error {
+			assert.Equal(t, signature.Signature, ingestionSignature.Signature)
+			assert.Equal(t, receivedAt.Unix(), ingestionSignature.SignedAt.Unix())
+			return nil
+		})
+
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, signature, receivedAt)
+	assert.Nil(t, appErr)
+
+	mockIngestionSignatureRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(gorm.ErrDuplicatedKey)
+
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, signature, receivedAt)
+	assert.NotNil(t, appErr)
+	assert.Equal(t, "replayed_request", appErr.Code)
+
+	mockIngestionSignatureRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	appErr = verifyIngestionSignature(mockIngestionSignatureRepository, apiKey, signature, receivedAt)
+	assert.NotNil(t, appErr)
+	assert.Equal(t, "unable_to_verify_signature", appErr.Code)
+}
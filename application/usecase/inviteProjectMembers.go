@@ -1,37 +1,34 @@
 package usecase
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"log/slog"
 
-	"github.com/RuanScherer/journey-track-api/adapters/emailtemplateadptr"
 	"github.com/RuanScherer/journey-track-api/application/kafka"
 	"github.com/RuanScherer/journey-track-api/application/repository"
 
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
-	"github.com/RuanScherer/journey-track-api/config"
 	"github.com/RuanScherer/journey-track-api/domain/model"
-	"github.com/matcornic/hermes/v2"
 	"gorm.io/gorm"
 )
 
 type InviteProjectMembersUseCase struct {
-	projectRepository       repository.ProjectRepository
-	userRepository          repository.UserRepository
-	projectInviteRepository repository.ProjectInviteRepository
-	producerFactory         kafka.ProducerFactory
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+	userRepository           repository.UserRepository
+	projectInviteRepository  repository.ProjectInviteRepository
+	producerFactory          kafka.ProducerFactory
 }
 
 func NewInviteProjectMembersUseCase(
+	projectPermissionService *ProjectPermissionService,
 	projectRepository repository.ProjectRepository,
 	userRepository repository.UserRepository,
 	projectInviteRepository repository.ProjectInviteRepository,
 	producerFactory kafka.ProducerFactory,
 ) *InviteProjectMembersUseCase {
 	return &InviteProjectMembersUseCase{
+		projectPermissionService,
 		projectRepository,
 		userRepository,
 		projectInviteRepository,
@@ -42,6 +39,10 @@ func NewInviteProjectMembersUseCase(
 func (useCase *InviteProjectMembersUseCase) Execute(
 	req *appmodel.InviteProjectMembersRequest,
 ) (*appmodel.InviteProjectMembersResponse, error) {
+	if len(req.UserIDs) == 0 && len(req.Emails) == 0 {
+		return nil, appmodel.NewAppError("no_invitees", "users or emails are required", appmodel.ErrorTypeValidation)
+	}
+
 	project, err := useCase.projectRepository.FindById(req.ProjectID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -59,20 +60,38 @@ func (useCase *InviteProjectMembersUseCase) Execute(
 		)
 	}
 
-	isMember := project.HasMember(actor)
-	if !isMember {
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		project.ID,
+		actor.ID,
+		ProjectPermissionManageInvites,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	role := req.Role
+	if role == "" {
+		role = model.ProjectInviteDefaultRole
+	}
+	if !actorMembership.CanAssignRole(role) {
 		return nil, appmodel.NewAppError(
-			"not_project_member",
-			"only project members can invite members",
-			appmodel.ErrorTypeValidation,
+			"role_not_assignable",
+			fmt.Sprintf("the %s role can't invite members as %s", actorMembership.Role, role),
+			appmodel.ErrorTypeForbidden,
 		)
 	}
 
-	invites, e := useCase.generateInvites(req.UserIDs, project)
+	invites, e := useCase.generateInvites(req.UserIDs, project, role, actor)
 	if e != nil {
 		return nil, e
 	}
 
+	emailInvites, e := useCase.generateEmailInvites(req.Emails, project, role, actor)
+	if e != nil {
+		return nil, e
+	}
+	invites = append(invites, emailInvites...)
+
 	// remove invites that already exist from the batch
 	invitesToCreate := make([]*model.ProjectInvite, 0)
 	for _, invite := range invites {
@@ -89,19 +108,7 @@ func (useCase *InviteProjectMembersUseCase) Execute(
 
 	var response appmodel.InviteProjectMembersResponse
 	for _, invite := range invites {
-		response = append(response, &appmodel.ProjectInvite{
-			ID: invite.ID,
-			Project: &appmodel.InviteProject{
-				ID:   invite.Project.ID,
-				Name: invite.Project.Name,
-			},
-			User: &appmodel.InviteUser{
-				ID:    invite.User.ID,
-				Email: *invite.User.Email,
-				Name:  invite.User.Name,
-			},
-			Status: invite.Status,
-		})
+		response = append(response, newProjectInviteResponse(invite))
 	}
 	return &response, nil
 }
@@ -109,10 +116,12 @@ func (useCase *InviteProjectMembersUseCase) Execute(
 func (useCase *InviteProjectMembersUseCase) generateInvites(
 	userIDs []string,
 	project *model.Project,
+	role string,
+	invitedBy *model.User,
 ) ([]*model.ProjectInvite, *appmodel.AppError) {
 	invites := make([]*model.ProjectInvite, 0)
 	for _, userID := range userIDs {
-		invite, err := useCase.generateInvite(userID, project)
+		invite, err := useCase.generateInvite(userID, project, role, invitedBy)
 		if err != nil {
 			return make([]*model.ProjectInvite, 0), err
 		}
@@ -122,7 +131,7 @@ func (useCase *InviteProjectMembersUseCase) generateInvites(
 }
 
 func (useCase *InviteProjectMembersUseCase) generateInvite(
-	userID string, project *model.Project,
+	userID string, project *model.Project, role string, invitedBy *model.User,
 ) (*model.ProjectInvite, *appmodel.AppError) {
 	user, err := useCase.userRepository.FindById(userID)
 	if err != nil {
@@ -131,7 +140,12 @@ func (useCase *InviteProjectMembersUseCase) generateInvite(
 		}
 		return nil, appmodel.NewAppError("unable_to_find_user", err.Error(), appmodel.ErrorTypeDatabase)
 	}
+	return useCase.generateUserInvite(user, project, role, invitedBy)
+}
 
+func (useCase *InviteProjectMembersUseCase) generateUserInvite(
+	user *model.User, project *model.Project, role string, invitedBy *model.User,
+) (*model.ProjectInvite, *appmodel.AppError) {
 	existentInvite, err := useCase.projectInviteRepository.FindPendingByUserAndProject(user.ID, project.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, appmodel.NewAppError(
@@ -145,84 +159,68 @@ func (useCase *InviteProjectMembersUseCase) generateInvite(
 		return existentInvite, nil
 	}
 
-	projectInvite, err := model.NewProjectInvite(project, user)
+	projectInvite, err := model.NewProjectInvite(project, user, role, invitedBy)
 	if err != nil {
 		return nil, appmodel.NewAppError("unable_to_invite_user", err.Error(), appmodel.ErrorTypeValidation)
 	}
 	return projectInvite, nil
 }
 
-func (useCase *InviteProjectMembersUseCase) sendProjectInviteEmails(invites []*model.ProjectInvite, actor *model.User) {
-	for _, invite := range invites {
-		go useCase.queueProjectInviteEmail(invite.ID, actor.Name)
+func (useCase *InviteProjectMembersUseCase) generateEmailInvites(
+	emails []string,
+	project *model.Project,
+	role string,
+	invitedBy *model.User,
+) ([]*model.ProjectInvite, *appmodel.AppError) {
+	invites := make([]*model.ProjectInvite, 0)
+	for _, email := range emails {
+		invite, err := useCase.generateEmailInvite(model.NormalizeProjectInviteEmail(email), project, role, invitedBy)
+		if err != nil {
+			return make([]*model.ProjectInvite, 0), err
+		}
+		invites = append(invites, invite)
 	}
+	return invites, nil
 }
 
-func (useCase *InviteProjectMembersUseCase) queueProjectInviteEmail(inviteId string, issuerName string) {
-	invite, err := useCase.projectInviteRepository.FindById(inviteId)
-	if err != nil {
-		slog.Error("Unable to find invite to send email", "error", err)
-		return
+// generateEmailInvite invites the user registered with the email, or the email itself when there is no such user
+func (useCase *InviteProjectMembersUseCase) generateEmailInvite(
+	email string, project *model.Project, role string, invitedBy *model.User,
+) (*model.ProjectInvite, *appmodel.AppError) {
+	user, err := useCase.userRepository.FindByEmail(email)
+	if err == nil {
+		return useCase.generateUserInvite(user, project, role, invitedBy)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, appmodel.NewAppError("unable_to_find_user", err.Error(), appmodel.ErrorTypeDatabase)
 	}
 
-	appConfig := config.GetAppConfig()
-	answerInviteLink := fmt.Sprintf(
-		"%s/answer-invitation?projectId=%s&token=%s",
-		appConfig.FrontendUrl,
-		invite.ProjectID,
-		*invite.Token,
-	)
-
-	emailTemplate := hermes.Email{
-		Body: hermes.Body{
-			Name:  invite.User.Name,
-			Title: "You have been invited to a project",
-			Intros: []string{
-				fmt.Sprintf("%s has invited you to join the project %s.", issuerName, invite.Project.Name),
-				"Join the project to start collaborating with the team.",
-			},
-			Actions: []hermes.Action{
-				{
-					Instructions: "Click the button below to answer the invite.",
-					Button: hermes.Button{
-						Color: "#f25d9c",
-						Text:  "Answer invite",
-						Link:  answerInviteLink,
-					},
-				},
-			},
-			Signature: "Regards",
-		},
-	}
-	content, err := emailtemplateadptr.GenerateEmailHtml(emailTemplate)
-	if err != nil {
-		log.Print(err)
-		return
+	existentInvite, err := useCase.projectInviteRepository.FindPendingByEmailAndProject(email, project.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, appmodel.NewAppError(
+			"unable_to_check_pending_invites",
+			err.Error(),
+			appmodel.ErrorTypeDatabase,
+		)
 	}
 
-	producer, err := useCase.producerFactory.NewProducer(map[string]any{
-		"bootstrap.servers": appConfig.KafkaBootstrapServers,
-		"retries":           3,
-		"retry.backoff.ms":  1000,
-	})
-	if err != nil {
-		slog.Error("Error setting kafka producer config", "error", err)
-		return
+	if existentInvite != nil {
+		return existentInvite, nil
 	}
 
-	payload, err := json.Marshal(kafka.EmailSendindRequestedPayload{
-		To:      *invite.User.Email,
-		Subject: "Trackr | You have been invited to a project",
-		Content: content,
-	})
+	projectInvite, err := model.NewProjectInviteByEmail(project, email, role, invitedBy)
 	if err != nil {
-		slog.Error("Error marshalling email sending payload", "error", err)
-		return
+		return nil, appmodel.NewAppError("unable_to_invite_email", err.Error(), appmodel.ErrorTypeValidation)
 	}
-	message := kafka.Message{Value: payload}
-	err = producer.Produce("email-sending-requested", message)
-	if err != nil {
-		slog.Error("Error producing kafka message to send email", "error", err)
-		return
+	return projectInvite, nil
+}
+
+func (useCase *InviteProjectMembersUseCase) sendProjectInviteEmails(invites []*model.ProjectInvite, actor *model.User) {
+	for _, invite := range invites {
+		go useCase.queueProjectInviteEmail(invite.ID, actor.Name)
 	}
 }
+
+func (useCase *InviteProjectMembersUseCase) queueProjectInviteEmail(inviteId string, issuerName string) {
+	queueProjectInviteInvitationEmail(useCase.projectInviteRepository, useCase.producerFactory, inviteId, issuerName)
+}
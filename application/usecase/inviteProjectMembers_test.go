@@ -20,7 +20,9 @@ func TestInviteProjectMembersUseCase_Execute(t *testing.T) {
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
 	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
 	producerFactory := kafka.NewMockProducerFactory(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
 	useCase := NewInviteProjectMembersUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
 		projectRepositoryMock,
 		userRepositoryMock,
 		projectInviteRepositoryMock,
@@ -30,15 +32,20 @@ func TestInviteProjectMembersUseCase_Execute(t *testing.T) {
 	req := &model.InviteProjectMembersRequest{
 		ActorID:   "fake-actor-id",
 		ProjectID: "fake-project-id",
-		UserIDs:   []string{"fake-user-id-1", "fake-user-id-2"},
 	}
 
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "no_invitees", err.(*model.AppError).Code)
+
+	req.UserIDs = []string{"fake-user-id-1", "fake-user-id-2"}
 	projectRepositoryMock.
 		EXPECT().
 		FindById(req.ProjectID).
 		Return(nil, gorm.ErrRecordNotFound)
 
-	res, err := useCase.Execute(req)
+	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [project_not_found] project not found")
@@ -74,18 +81,65 @@ func TestInviteProjectMembersUseCase_Execute(t *testing.T) {
 		EXPECT().
 		FindById(req.ActorID).
 		Return(actor, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_project_member", err.(*model.AppError).Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(actor, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    actor.ID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [not_project_member] only project members can invite members")
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
 
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		Return(actor, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, actor.ID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    actor.ID,
+			Role:      domainmodel.ProjectRoleAdmin,
+		}, nil)
+	req.Role = domainmodel.ProjectRoleAdmin
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "role_not_assignable", err.(*model.AppError).Code)
+
+	req.Role = ""
 	actor.ID = project.OwnerID
 	userRepositoryMock.
 		EXPECT().
 		FindById(req.ActorID).
 		AnyTimes().
 		Return(actor, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
 	userRepositoryMock.
 		EXPECT().
 		FindById(req.UserIDs[0]).
@@ -128,7 +182,7 @@ func TestInviteProjectMembersUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_check_pending_invites] unexpected error")
 
-	existentInvitation, _ := domainmodel.NewProjectInvite(project, user1)
+	existentInvitation, _ := domainmodel.NewProjectInvite(project, user1, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteRepositoryMock.
 		EXPECT().
 		FindPendingByUserAndProject(user1.ID, project.ID).
@@ -170,13 +224,57 @@ func TestInviteProjectMembersUseCase_Execute(t *testing.T) {
 	assert.NotNil(t, res)
 	invitations := *res
 	assert.Len(t, invitations, 2)
+	assert.Equal(t, domainmodel.ProjectRoleViewer, invitations[0].Role)
 	assert.Equal(t, invitations[1].ID, existentInvitation.ID)
 	assert.Equal(t, invitations[1].Project.ID, existentInvitation.ProjectID)
 	assert.Equal(t, invitations[1].Project.Name, existentInvitation.Project.Name)
-	assert.Equal(t, invitations[1].User.ID, existentInvitation.UserID)
+	assert.Equal(t, invitations[1].User.ID, *existentInvitation.UserID)
 	assert.Equal(t, invitations[1].User.Email, *existentInvitation.User.Email)
 	assert.Equal(t, invitations[1].User.Name, existentInvitation.User.Name)
 	assert.Equal(t, invitations[1].Status, existentInvitation.Status)
+
+	req.UserIDs = nil
+	req.Emails = []string{" New.Person@Gmail.com ", "user2@gmail.com"}
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("new.person@gmail.com").
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_user", err.(*model.AppError).Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("new.person@gmail.com").
+		Return(nil, gorm.ErrRecordNotFound)
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail("user2@gmail.com").
+		Return(user2, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindPendingByEmailAndProject("new.person@gmail.com", project.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindPendingByUserAndProject(user2.ID, project.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+	projectInviteRepositoryMock.
+		EXPECT().
+		BatchCreate(gomock.Len(2)).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	invitations = *res
+	assert.Len(t, invitations, 2)
+	assert.Equal(t, "new.person@gmail.com", invitations[0].Email)
+	assert.Nil(t, invitations[0].User)
+	assert.Equal(t, "user2@gmail.com", invitations[1].Email)
+	assert.Equal(t, user2.ID, invitations[1].User.ID)
 }
 
 func TestInviteProjectMembersUseCase_queueProjectInviteEmail(t *testing.T) {
@@ -185,7 +283,9 @@ func TestInviteProjectMembersUseCase_queueProjectInviteEmail(t *testing.T) {
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
 	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
 	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
 	useCase := NewInviteProjectMembersUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
 		projectRepositoryMock,
 		userRepositoryMock,
 		projectInviteRepositoryMock,
@@ -194,7 +294,7 @@ func TestInviteProjectMembersUseCase_queueProjectInviteEmail(t *testing.T) {
 
 	project, _ := factory.NewProjectWithDefaultOwner("fake project")
 	user, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
-	invitation, _ := domainmodel.NewProjectInvite(project, user)
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 
 	projectInviteRepositoryMock.
 		EXPECT().
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+type JoinProjectByInviteLinkUseCase struct {
+	userRepository              repository.UserRepository
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository
+}
+
+func NewJoinProjectByInviteLinkUseCase(
+	userRepository repository.UserRepository,
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository,
+) *JoinProjectByInviteLinkUseCase {
+	return &JoinProjectByInviteLinkUseCase{userRepository, projectInviteLinkRepository}
+}
+
+func (useCase *JoinProjectByInviteLinkUseCase) Execute(
+	req *appmodel.JoinProjectByInviteLinkRequest,
+) (*appmodel.JoinProjectByInviteLinkResponse, error) {
+	link, err := useCase.projectInviteLinkRepository.FindByToken(req.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invite_link_not_found", "invite link not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_invite_link", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	user, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to join the project",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	join, err := link.Join(link.Project, user, time.Now())
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_join_project", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectInviteLinkRepository.Join(link, join)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError(
+				"unable_to_join_project",
+				"invite link is no longer usable",
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		return nil, appmodel.NewAppError("unable_to_save_project_join", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return &appmodel.JoinProjectByInviteLinkResponse{
+		ProjectID: join.ProjectID,
+		Role:      join.Role,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestJoinProjectByInviteLinkUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectInviteLinkRepositoryMock := repository.NewMockProjectInviteLinkRepository(ctrl)
+	useCase := NewJoinProjectByInviteLinkUseCase(userRepositoryMock, projectInviteLinkRepositoryMock)
+
+	req := &appmodel.JoinProjectByInviteLinkRequest{
+		ActorID: "fake-actor-id",
+		Token:   "fake-token",
+	}
+
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindByToken(req.Token).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invite_link_not_found", err.(*appmodel.AppError).Code)
+
+	// the link is loaded again on every attempt, so each one gets a fresh project
+	newLink := func(maxUses int) *domainmodel.ProjectInviteLink {
+		project, _ := factory.NewProjectWithDefaultOwner("fake project")
+		link, _ := domainmodel.NewProjectInviteLink(
+			project,
+			project.Memberships[0],
+			domainmodel.ProjectRoleEditor,
+			&maxUses,
+			time.Now().Add(time.Hour),
+		)
+		return link
+	}
+	user, _ := factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		AnyTimes().
+		Return(user, nil)
+
+	usedLink := newLink(1)
+	usedLink.UsesCount = 1
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindByToken(req.Token).
+		Return(usedLink, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_join_project", err.(*appmodel.AppError).Code)
+
+	link := newLink(1)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindByToken(req.Token).
+		Return(link, nil)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Join(link, gomock.Any()).
+		Return(gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [unable_to_join_project]: invite link is no longer usable")
+
+	link = newLink(1)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindByToken(req.Token).
+		Return(link, nil)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Join(link, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_project_join", err.(*appmodel.AppError).Code)
+
+	link = newLink(1)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindByToken(req.Token).
+		Return(link, nil)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Join(link, gomock.Any()).
+		DoAndReturn(func(link *domainmodel.ProjectInviteLink, join *domainmodel.ProjectInviteLinkJoin) error {
+			assert.Equal(t, link.ID, join.ProjectInviteLinkID)
+			assert.Equal(t, user.ID, join.UserID)
+			return nil
+		})
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Equal(t, link.ProjectID, res.ProjectID)
+	assert.Equal(t, domainmodel.ProjectRoleEditor, res.Role)
+	assert.True(t, link.Project.HasMember(user))
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"errors"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+type LeaveProjectUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+	projectMemberRepository  repository.ProjectMemberRepository
+	projectInviteRepository  repository.ProjectInviteRepository
+}
+
+func NewLeaveProjectUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	projectMemberRepository repository.ProjectMemberRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+) *LeaveProjectUseCase {
+	return &LeaveProjectUseCase{
+		projectPermissionService,
+		projectRepository,
+		projectMemberRepository,
+		projectInviteRepository,
+	}
+}
+
+func (useCase *LeaveProjectUseCase) Execute(req *appmodel.LeaveProjectRequest) error {
+	membership, appErr := useCase.projectPermissionService.Authorize(
+		req.ProjectID,
+		req.ActorID,
+		ProjectPermissionViewProject,
+	)
+	if appErr != nil {
+		return appErr
+	}
+
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	err = project.RemoveMember(req.ActorID)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_leave_project", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	return deleteProjectMembership(useCase.projectMemberRepository, useCase.projectInviteRepository, membership)
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestLeaveProjectUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	useCase := NewLeaveProjectUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		projectMemberRepositoryMock,
+		projectInviteRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	viewer, _ := factory.NewVerifiedUser("viewer@gmail.com", "Viewer", "fake-password")
+	_ = project.AddMember(viewer, domainmodel.ProjectRoleViewer)
+
+	req := &model.LeaveProjectRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: project.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_project_member", err.(*model.AppError).Code)
+
+	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_project", err.(*model.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		AnyTimes().
+		Return(project, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_leave_project", err.(*model.AppError).Code)
+
+	req.ActorID = viewer.ID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, viewer.ID).
+		AnyTimes().
+		Return(project.FindMembership(viewer.ID), nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		DeletePendingByProjectAndInviter(project.ID, viewer.ID).
+		Return(nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		Delete(gomock.Any()).
+		DoAndReturn(func(member *domainmodel.ProjectMember) error {
+			assert.Equal(t, viewer.ID, member.UserID)
+			return nil
+		})
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.False(t, project.HasMember(viewer))
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListPersonalAccessTokensUseCase struct {
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+}
+
+func NewListPersonalAccessTokensUseCase(
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+) *ListPersonalAccessTokensUseCase {
+	return &ListPersonalAccessTokensUseCase{personalAccessTokenRepository}
+}
+
+func (useCase *ListPersonalAccessTokensUseCase) Execute(
+	req *appmodel.ListPersonalAccessTokensRequest,
+) (*appmodel.ListPersonalAccessTokensResponse, error) {
+	tokens, err := useCase.personalAccessTokenRepository.ListActiveByUser(req.ActorID, time.Now())
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_tokens", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListPersonalAccessTokensResponse, 0, len(tokens))
+	for _, token := range tokens {
+		response = append(response, newPersonalAccessTokenResponse(token))
+	}
+	return &response, nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListProjectApiKeysUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectApiKeyRepository  repository.ProjectApiKeyRepository
+}
+
+func NewListProjectApiKeysUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *ListProjectApiKeysUseCase {
+	return &ListProjectApiKeysUseCase{projectPermissionService, projectApiKeyRepository}
+}
+
+func (useCase *ListProjectApiKeysUseCase) Execute(
+	req *appmodel.ListProjectApiKeysRequest,
+) (*appmodel.ListProjectApiKeysResponse, error) {
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageApiKeys)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	apiKeys, err := useCase.projectApiKeyRepository.FindByProjectId(req.ProjectID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_api_keys", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListProjectApiKeysResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		response = append(response, newProjectApiKeyResponse(apiKey))
+	}
+	return &response, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestListProjectApiKeysUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewListProjectApiKeysUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectApiKeyRepositoryMock)
+
+	req := &appmodel.ListProjectApiKeysRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: "fake-project-id",
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can manage api keys")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindByProjectId(req.ProjectID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_list_api_keys]: unexpected error")
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeRead)
+	_ = apiKey.Revoke()
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindByProjectId(req.ProjectID).
+		Return([]*domainmodel.ProjectApiKey{apiKey}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, *res, 1)
+	assert.Equal(t, apiKey.ID, (*res)[0].ID)
+	assert.Equal(t, apiKey.Prefix, (*res)[0].Prefix)
+	assert.NotNil(t, (*res)[0].RevokedAt)
+}
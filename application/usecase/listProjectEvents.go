@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+const (
+	DefaultProjectEventsPageSize = 50
+	MaxProjectEventsPageSize     = 500
+)
+
+type ListProjectEventsUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	eventRepository          repository.EventRepository
+}
+
+func NewListProjectEventsUseCase(
+	projectPermissionService *ProjectPermissionService,
+	eventRepository repository.EventRepository,
+) *ListProjectEventsUseCase {
+	return &ListProjectEventsUseCase{projectPermissionService, eventRepository}
+}
+
+func (useCase *ListProjectEventsUseCase) Execute(
+	req *appmodel.ListProjectEventsRequest,
+) (*appmodel.ListProjectEventsResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	for key := range req.Properties {
+		if !model.IsValidEventPropertyKey(key) {
+			return nil, appmodel.NewAppError(
+				"invalid_property_filter",
+				fmt.Sprintf("invalid property key %q", key),
+				appmodel.ErrorTypeValidation,
+			)
+		}
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	var cursor *repository.EventCursor
+	if req.Cursor != "" {
+		var err error
+		cursor, err = decodeEventCursor(req.Cursor)
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_cursor", "invalid cursor", appmodel.ErrorTypeValidation)
+		}
+	}
+
+	order := req.Order
+	if order == "" {
+		order = repository.EventListOrderDesc
+	}
+
+	pageSize := req.Limit
+	switch {
+	case pageSize > MaxProjectEventsPageSize:
+		pageSize = MaxProjectEventsPageSize
+	case pageSize <= 0:
+		pageSize = DefaultProjectEventsPageSize
+	}
+
+	// one extra event is loaded to know whether there's a next page
+	events, err := useCase.eventRepository.List(repository.EventListOptions{
+		ProjectID:  req.ProjectID,
+		Name:       req.Name,
+		From:       req.From,
+		To:         req.To,
+		Properties: req.Properties,
+		Order:      order,
+		After:      cursor,
+		Limit:      pageSize + 1,
+	})
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_events", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := &appmodel.ListProjectEventsResponse{
+		Events: make([]*appmodel.ProjectEvent, 0, len(events)),
+	}
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		lastEvent := events[len(events)-1]
+		nextCursor := encodeEventCursor(&repository.EventCursor{
+			Timestamp: *lastEvent.Timestamp,
+			ID:        lastEvent.ID,
+		})
+		response.NextCursor = &nextCursor
+	}
+
+	for _, event := range events {
+		response.Events = append(response.Events, &appmodel.ProjectEvent{
+			ID:              event.ID,
+			Name:            event.Name,
+			Timestamp:       *event.Timestamp,
+			ClientTimestamp: event.ClientTimestamp,
+			ReceivedAt:      *event.ReceivedAt,
+			ClientEventID:   event.ClientEventID,
+			DistinctID:      event.DistinctID,
+			AnonymousID:     event.AnonymousID,
+			SessionID:       event.SessionID,
+			Properties:      event.Properties,
+		})
+	}
+	return response, nil
+}
+
+func encodeEventCursor(cursor *repository.EventCursor) string {
+	encodedCursor, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encodedCursor)
+}
+
+func decodeEventCursor(encodedCursor string) (*repository.EventCursor, error) {
+	decodedCursor, err := base64.RawURLEncoding.DecodeString(encodedCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &repository.EventCursor{}
+	err = json.Unmarshal(decodedCursor, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.ID == "" || cursor.Timestamp.IsZero() {
+		return nil, errors.New("incomplete cursor")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestListProjectEventsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	eventRepositoryMock := repository.NewMockEventRepository(ctrl)
+	useCase := NewListProjectEventsUseCase(NewProjectPermissionService(projectMemberRepositoryMock), eventRepositoryMock)
+
+	req := &model.ListProjectEventsRequest{
+		Principal: model.Principal{UserID: "fake-actor-id"},
+		ProjectID: "fake-project-id",
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project events")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	req.Properties = map[string]string{"invalid key": "value"}
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_property_filter", err.(*model.AppError).Code)
+
+	req.Properties = map[string]string{"plan": "pro"}
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	req.From, req.To = &from, &to
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_time_range", err.(*model.AppError).Code)
+
+	req.From, req.To = nil, nil
+	req.Cursor = "invalid cursor"
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_cursor", err.(*model.AppError).Code)
+
+	req.Cursor = ""
+	eventRepositoryMock.
+		EXPECT().
+		List(gomock.Any()).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_list_events]: unexpected error")
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	firstEvent, _ := domainmodel.NewEvent("first event", map[string]any{"plan": "pro"}, project)
+	secondEvent, _ := domainmodel.NewEvent("second event", map[string]any{"plan": "pro"}, project)
+	req.Limit = 1
+	eventRepositoryMock.
+		EXPECT().
+		List(repository.EventListOptions{
+			ProjectID:  req.ProjectID,
+			Properties: req.Properties,
+			Order:      repository.EventListOrderDesc,
+			Limit:      2,
+		}).
+		Return([]*domainmodel.Event{firstEvent, secondEvent}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Events, 1)
+	assert.Equal(t, firstEvent.ID, res.Events[0].ID)
+	assert.Equal(t, "pro", res.Events[0].Properties["plan"])
+	assert.NotNil(t, res.NextCursor)
+
+	req.Cursor = *res.NextCursor
+	req.Order = repository.EventListOrderAsc
+	eventRepositoryMock.
+		EXPECT().
+		List(gomock.Any()).
+		DoAndReturn(func(options repository.EventListOptions) ([]*domainmodel.Event, error) {
+			assert.Equal(t, repository.EventListOrderAsc, options.Order)
+			assert.Equal(t, firstEvent.ID, options.After.ID)
+			assert.True(t, firstEvent.Timestamp.Equal(options.After.Timestamp))
+			return []*domainmodel.Event{secondEvent}, nil
+		})
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.Events, 1)
+	assert.Equal(t, secondEvent.ID, res.Events[0].ID)
+	assert.Nil(t, res.NextCursor)
+}
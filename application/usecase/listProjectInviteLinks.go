@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListProjectInviteLinksUseCase struct {
+	projectPermissionService    *ProjectPermissionService
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository
+}
+
+func NewListProjectInviteLinksUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository,
+) *ListProjectInviteLinksUseCase {
+	return &ListProjectInviteLinksUseCase{projectPermissionService, projectInviteLinkRepository}
+}
+
+func (useCase *ListProjectInviteLinksUseCase) Execute(
+	req *appmodel.ListProjectInviteLinksRequest,
+) (*appmodel.ListProjectInviteLinksResponse, error) {
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageInvites)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	links, err := useCase.projectInviteLinkRepository.ListByProject(req.ProjectID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_invite_links", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListProjectInviteLinksResponse, 0, len(links))
+	for _, link := range links {
+		response = append(response, newProjectInviteLinkResponse(link))
+	}
+	return &response, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListProjectInviteLinksUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectInviteLinkRepositoryMock := repository.NewMockProjectInviteLinkRepository(ctrl)
+	useCase := NewListProjectInviteLinksUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectInviteLinkRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	req := &appmodel.ListProjectInviteLinksRequest{
+		ActorID:   project.OwnerID,
+		ProjectID: project.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		ListByProject(req.ProjectID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_list_invite_links", err.(*appmodel.AppError).Code)
+
+	activeLink, _ := domainmodel.NewProjectInviteLink(
+		project,
+		project.Memberships[0],
+		domainmodel.ProjectRoleViewer,
+		nil,
+		time.Now().Add(time.Hour),
+	)
+	revokedLink, _ := domainmodel.NewProjectInviteLink(
+		project,
+		project.Memberships[0],
+		domainmodel.ProjectRoleEditor,
+		nil,
+		time.Now().Add(time.Hour),
+	)
+	_ = revokedLink.Revoke()
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		ListByProject(req.ProjectID).
+		Return([]*domainmodel.ProjectInviteLink{activeLink, revokedLink}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	links := *res
+	assert.Len(t, links, 2)
+	assert.Equal(t, activeLink.ID, links[0].ID)
+	assert.True(t, links[0].Active)
+	assert.Equal(t, revokedLink.ID, links[1].ID)
+	assert.False(t, links[1].Active)
+	assert.NotNil(t, links[1].RevokedAt)
+}
@@ -9,34 +9,26 @@ import (
 )
 
 type ListProjectInvitesUseCase struct {
-	projectInviteRepository repository2.ProjectInviteRepository
-	projectRepository       repository2.ProjectRepository
+	projectInviteRepository  repository2.ProjectInviteRepository
+	projectPermissionService *ProjectPermissionService
 }
 
 func NewListProjectInvitesUseCase(
 	projectInviteRepository repository2.ProjectInviteRepository,
-	projectRepository repository2.ProjectRepository,
+	projectPermissionService *ProjectPermissionService,
 ) *ListProjectInvitesUseCase {
 	return &ListProjectInvitesUseCase{
 		projectInviteRepository,
-		projectRepository,
+		projectPermissionService,
 	}
 }
 
 func (useCase *ListProjectInvitesUseCase) Execute(
 	req *appmodel.ListProjectInvitesRequest,
 ) (*appmodel.ListProjectInvitesResponse, error) {
-	isMember, err := useCase.projectRepository.HasMember(req.ProjectID, req.ActorID)
-	if err != nil {
-		return nil, appmodel.NewAppError("unable_to_check_membership", err.Error(), appmodel.ErrorTypeDatabase)
-	}
-
-	if !isMember {
-		return nil, appmodel.NewAppError(
-			"not_project_member",
-			"only project members can see the project invites",
-			appmodel.ErrorTypeValidation,
-		)
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageInvites)
+	if appErr != nil {
+		return nil, appErr
 	}
 
 	status := req.Status
@@ -58,19 +50,7 @@ func (useCase *ListProjectInvitesUseCase) Execute(
 
 	var invitesResponse []*appmodel.ProjectInvite
 	for _, invite := range invites {
-		invitesResponse = append(invitesResponse, &appmodel.ProjectInvite{
-			ID: invite.ID,
-			Project: &appmodel.InviteProject{
-				ID:   invite.Project.ID,
-				Name: invite.Project.Name,
-			},
-			User: &appmodel.InviteUser{
-				ID:    invite.User.ID,
-				Name:  invite.User.Name,
-				Email: *invite.User.Email,
-			},
-			Status: invite.Status,
-		})
+		invitesResponse = append(invitesResponse, newProjectInviteResponse(invite))
 	}
 	return &invitesResponse, nil
 }
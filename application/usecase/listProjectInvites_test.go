@@ -15,8 +15,8 @@ import (
 func TestListProjectInvitesUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectInviteMockRepository := repository.NewMockProjectInviteRepository(ctrl)
-	projectMockRepository := repository.NewMockProjectRepository(ctrl)
-	useCase := NewListProjectInvitesUseCase(projectInviteMockRepository, projectMockRepository)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewListProjectInvitesUseCase(projectInviteMockRepository, NewProjectPermissionService(projectMemberRepositoryMock))
 
 	req := &model.ListProjectInvitesRequest{
 		ActorID:   "fake-actor-id",
@@ -24,31 +24,35 @@ func TestListProjectInvitesUseCase_Execute(t *testing.T) {
 		Status:    "",
 	}
 
-	projectMockRepository.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
-		Return(false, errors.New("unexpected error"))
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(nil, errors.New("unexpected error"))
 
 	res, err := useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(database) [unable_to_check_membership] unexpected errpr")
 
-	projectMockRepository.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
-		Return(false, nil)
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "(validation) [not_project_member] only project members can see the project invites")
 
-	projectMockRepository.
+	projectMemberRepositoryMock.
 		EXPECT().
-		HasMember(req.ProjectID, req.ActorID).
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
 		AnyTimes().
-		Return(true, nil)
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleAdmin,
+		}, nil)
 	projectInviteMockRepository.
 		EXPECT().
 		ListByProjectAndStatus(req.ProjectID, domainmodel.ProjectInviteStatusPending).
@@ -73,8 +77,8 @@ func TestListProjectInvitesUseCase_Execute(t *testing.T) {
 	user1, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
 	user2, _ := factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
 	project, _ := factory.NewProjectWithDefaultOwner("fake project")
-	invitation1, _ := domainmodel.NewProjectInvite(project, user1)
-	invitation2, _ := domainmodel.NewProjectInvite(project, user2)
+	invitation1, _ := domainmodel.NewProjectInvite(project, user1, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
+	invitation2, _ := domainmodel.NewProjectInvite(project, user2, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteMockRepository.
 		EXPECT().
 		ListByProjectAndStatus(req.ProjectID, domainmodel.ProjectInviteStatusAccepted).
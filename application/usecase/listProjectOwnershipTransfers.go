@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListProjectOwnershipTransfersUseCase struct {
+	projectPermissionService           *ProjectPermissionService
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository
+}
+
+func NewListProjectOwnershipTransfersUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository,
+) *ListProjectOwnershipTransfersUseCase {
+	return &ListProjectOwnershipTransfersUseCase{projectPermissionService, projectOwnershipTransferRepository}
+}
+
+func (useCase *ListProjectOwnershipTransfersUseCase) Execute(
+	req *appmodel.ListProjectOwnershipTransfersRequest,
+) (*appmodel.ListProjectOwnershipTransfersResponse, error) {
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageMembers)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	transfers, err := useCase.projectOwnershipTransferRepository.ListByProject(req.ProjectID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_ownership_transfers", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListProjectOwnershipTransfersResponse, 0, len(transfers))
+	for _, transfer := range transfers {
+		response = append(response, newProjectOwnershipTransferResponse(transfer))
+	}
+	return &response, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListProjectOwnershipTransfersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectOwnershipTransferRepositoryMock := repository.NewMockProjectOwnershipTransferRepository(ctrl)
+	useCase := NewListProjectOwnershipTransfersUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectOwnershipTransferRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	nominee, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	_ = project.AddMember(nominee, domainmodel.ProjectRoleViewer)
+
+	req := &model.ListProjectOwnershipTransfersRequest{
+		ActorID:   nominee.ID,
+		ProjectID: project.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, nominee.ID).
+		Return(project.FindMembership(nominee.ID), nil)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
+
+	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		ListByProject(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_list_ownership_transfers", err.(*model.AppError).Code)
+
+	transfer, _ := domainmodel.NewProjectOwnershipTransfer(project, nominee)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		ListByProject(project.ID).
+		Return([]*domainmodel.ProjectOwnershipTransfer{transfer}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Len(t, *res, 1)
+	assert.Equal(t, transfer.ID, (*res)[0].ID)
+	assert.Equal(t, nominee.ID, (*res)[0].ToUserID)
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListProjectSessionsUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	endUserSessionRepository repository.EndUserSessionRepository
+}
+
+func NewListProjectSessionsUseCase(
+	projectPermissionService *ProjectPermissionService,
+	endUserSessionRepository repository.EndUserSessionRepository,
+) *ListProjectSessionsUseCase {
+	return &ListProjectSessionsUseCase{projectPermissionService, endUserSessionRepository}
+}
+
+func (useCase *ListProjectSessionsUseCase) Execute(
+	req *appmodel.ListProjectSessionsRequest,
+) (*appmodel.ListProjectSessionsResponse, error) {
+	appErr := useCase.projectPermissionService.AuthorizePrincipal(req.ProjectID, req.Principal, ProjectPermissionViewAnalytics)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !req.From.Before(req.To) {
+		return nil, appmodel.NewAppError("invalid_time_range", "from should be before to", appmodel.ErrorTypeValidation)
+	}
+
+	sessions, err := useCase.endUserSessionRepository.List(repository.EndUserSessionListOptions{
+		ProjectID: req.ProjectID,
+		EndUserID: req.EndUserID,
+		From:      req.From,
+		To:        req.To,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+	})
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_sessions", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListProjectSessionsResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, &appmodel.ProjectSession{
+			ID:              session.ID,
+			EndUserID:       session.EndUserID,
+			ClientSessionID: session.ClientSessionID,
+			StartedAt:       session.StartedAt,
+			EndedAt:         session.EndedAt,
+			DurationSeconds: session.DurationSeconds,
+			EventCount:      session.EventCount,
+			EntryEvent:      session.EntryEvent,
+			ExitEvent:       session.ExitEvent,
+		})
+	}
+	return &response, nil
+}
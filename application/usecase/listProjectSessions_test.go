@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestListProjectSessionsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	endUserSessionRepositoryMock := repository.NewMockEndUserSessionRepository(ctrl)
+	useCase := NewListProjectSessionsUseCase(NewProjectPermissionService(projectMemberRepositoryMock), endUserSessionRepositoryMock)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := &model.ListProjectSessionsRequest{
+		Principal: model.Principal{UserID: "fake-actor-id"},
+		ProjectID: "fake-project-id",
+		EndUserID: "user-1",
+		From:      from,
+		To:        from,
+		Page:      2,
+		PageSize:  20,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can see project sessions")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.Principal.UserID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.Principal.UserID,
+			Role:      domainmodel.ProjectRoleViewer,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_time_range", err.(*model.AppError).Code)
+
+	req.To = from.AddDate(0, 1, 0)
+	expectedOptions := repository.EndUserSessionListOptions{
+		ProjectID: req.ProjectID,
+		EndUserID: req.EndUserID,
+		From:      req.From,
+		To:        req.To,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+	}
+	endUserSessionRepositoryMock.
+		EXPECT().
+		List(expectedOptions).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_list_sessions]: unexpected error")
+
+	endUserSessionRepositoryMock.
+		EXPECT().
+		List(expectedOptions).
+		Return([]*domainmodel.EndUserSession{
+			{
+				ID:              "fake-session-id",
+				EndUserID:       "user-1",
+				StartedAt:       from,
+				EndedAt:         from.Add(time.Minute),
+				DurationSeconds: 60,
+				EventCount:      3,
+				EntryEvent:      "home",
+				ExitEvent:       "purchase",
+			},
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, *res, 1)
+	assert.Equal(t, "fake-session-id", (*res)[0].ID)
+	assert.Equal(t, 60.0, (*res)[0].DurationSeconds)
+	assert.Equal(t, "purchase", (*res)[0].ExitEvent)
+}
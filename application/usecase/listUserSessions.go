@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type ListUserSessionsUseCase struct {
+	userSessionRepository repository.UserSessionRepository
+}
+
+func NewListUserSessionsUseCase(userSessionRepository repository.UserSessionRepository) *ListUserSessionsUseCase {
+	return &ListUserSessionsUseCase{userSessionRepository}
+}
+
+func (useCase *ListUserSessionsUseCase) Execute(
+	req *appmodel.ListUserSessionsRequest,
+) (*appmodel.ListUserSessionsResponse, error) {
+	sessions, err := useCase.userSessionRepository.ListActiveByUser(req.ActorID, time.Now())
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_sessions", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := make(appmodel.ListUserSessionsResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, newUserSessionResponse(session, req.CurrentSessionID))
+	}
+	return &response, nil
+}
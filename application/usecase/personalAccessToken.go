@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"errors"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+func newPersonalAccessTokenResponse(token *model.PersonalAccessToken) *appmodel.PersonalAccessToken {
+	return &appmodel.PersonalAccessToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scope:      token.Scope,
+		ProjectID:  token.ProjectID,
+		Prefix:     token.Prefix,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+		ExpiresAt:  token.ExpiresAt,
+	}
+}
+
+// findPersonalAccessToken hides tokens of other users as if they didn't exist
+func findPersonalAccessToken(
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+	userID string,
+	tokenID string,
+) (*model.PersonalAccessToken, *appmodel.AppError) {
+	token, err := personalAccessTokenRepository.FindById(tokenID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("token_not_found", "token not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if token.UserID != userID {
+		return nil, appmodel.NewAppError("token_not_found", "token not found", appmodel.ErrorTypeValidation)
+	}
+	return token, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+// authenticateProjectApiKey finds the active api key matching the plain key and checks it grants the scope
+func authenticateProjectApiKey(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+	plainKey string,
+	scope string,
+) (*model.ProjectApiKey, *appmodel.AppError) {
+	if plainKey == "" {
+		return nil, appmodel.NewAppError("invalid_api_key", "invalid api key", appmodel.ErrorTypeAuthentication)
+	}
+
+	apiKey, err := projectApiKeyRepository.FindByKeyHash(model.HashProjectApiKey(plainKey))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invalid_api_key", "invalid api key", appmodel.ErrorTypeAuthentication)
+		}
+		return nil, appmodel.NewAppError("unable_to_authenticate_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	now := time.Now()
+	if !apiKey.IsActive(now) {
+		return nil, appmodel.NewAppError("invalid_api_key", "invalid api key", appmodel.ErrorTypeAuthentication)
+	}
+
+	if apiKey.Scope != scope {
+		return nil, appmodel.NewAppError(
+			"api_key_scope_not_allowed",
+			"api key scope doesn't allow this operation",
+			appmodel.ErrorTypeAuthentication,
+		)
+	}
+
+	if apiKey.MarkUsed(now) {
+		err = projectApiKeyRepository.UpdateLastUsedAt(apiKey.ID, now)
+		if err != nil {
+			slog.Error("Unable to update api key last used timestamp", "error", err)
+		}
+	}
+	return apiKey, nil
+}
+
+func newProjectApiKeyResponse(apiKey *model.ProjectApiKey) *appmodel.ProjectApiKey {
+	return &appmodel.ProjectApiKey{
+		ID:            apiKey.ID,
+		Name:          apiKey.Name,
+		Scope:         apiKey.Scope,
+		Prefix:        apiKey.Prefix,
+		CreatedByID:   apiKey.CreatedByID,
+		CreatedAt:     apiKey.CreatedAt,
+		LastUsedAt:    apiKey.LastUsedAt,
+		ExpiresAt:     apiKey.ExpiresAt,
+		RevokedAt:     apiKey.RevokedAt,
+		AllowUnsigned: apiKey.AllowUnsigned,
+	}
+}
+
+func findProjectApiKey(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+	projectID string,
+	apiKeyID string,
+) (*model.ProjectApiKey, *appmodel.AppError) {
+	apiKey, err := projectApiKeyRepository.FindById(apiKeyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("api_key_not_found", "api key not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if apiKey.ProjectID != projectID {
+		return nil, appmodel.NewAppError("api_key_not_found", "api key not found", appmodel.ErrorTypeValidation)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// newProjectInviteResponse expects the project to be loaded, the user is left empty for invites sent by email
+func newProjectInviteResponse(invite *model.ProjectInvite) *appmodel.ProjectInvite {
+	response := &appmodel.ProjectInvite{
+		ID: invite.ID,
+		Project: &appmodel.InviteProject{
+			ID:   invite.Project.ID,
+			Name: invite.Project.Name,
+		},
+		Email:     invite.Email,
+		Status:    invite.Status,
+		Role:      invite.Role,
+		ExpiresAt: invite.ExpiresAt,
+	}
+	if invite.User != nil {
+		response.User = &appmodel.InviteUser{
+			ID:    invite.User.ID,
+			Email: *invite.User.Email,
+			Name:  invite.User.Name,
+		}
+	}
+	return response
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/RuanScherer/journey-track-api/adapters/emailtemplateadptr"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/matcornic/hermes/v2"
+)
+
+// produceProjectInviteEmail queues an email with the link to answer the invite, which needs its project loaded.
+// Invites sent to an email without an account link to the registration instead.
+func produceProjectInviteEmail(
+	producerFactory kafka.ProducerFactory,
+	invite *model.ProjectInvite,
+	subject string,
+	title string,
+	intros []string,
+) error {
+	appConfig := config.GetAppConfig()
+	answerInviteLink := fmt.Sprintf(
+		"%s/answer-invitation?projectId=%s&token=%s",
+		appConfig.FrontendUrl,
+		invite.ProjectID,
+		*invite.Token,
+	)
+	instructions := "Click the button below to answer the invite."
+	buttonText := "Answer invite"
+	recipientName := ""
+	if invite.User != nil {
+		recipientName = invite.User.Name
+	} else {
+		answerInviteLink = fmt.Sprintf(
+			"%s/register?email=%s&projectId=%s&token=%s",
+			appConfig.FrontendUrl,
+			url.QueryEscape(invite.Email),
+			invite.ProjectID,
+			*invite.Token,
+		)
+		instructions = "Create your account with this email to answer the invite."
+		buttonText = "Create account"
+	}
+
+	var outros []string
+	if invite.ExpiresAt != nil {
+		outros = append(outros, fmt.Sprintf("This invite expires on %s.", invite.ExpiresAt.Format("January 2, 2006")))
+	}
+
+	emailTemplate := hermes.Email{
+		Body: hermes.Body{
+			Name:   recipientName,
+			Title:  title,
+			Intros: intros,
+			Actions: []hermes.Action{
+				{
+					Instructions: instructions,
+					Button: hermes.Button{
+						Color: "#f25d9c",
+						Text:  buttonText,
+						Link:  answerInviteLink,
+					},
+				},
+			},
+			Outros:    outros,
+			Signature: "Regards",
+		},
+	}
+	content, err := emailtemplateadptr.GenerateEmailHtml(emailTemplate)
+	if err != nil {
+		return fmt.Errorf("error generating email html: %w", err)
+	}
+
+	producer, err := producerFactory.NewProducer(map[string]any{
+		"bootstrap.servers": appConfig.KafkaBootstrapServers,
+		"retries":           3,
+		"retry.backoff.ms":  1000,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting kafka producer config: %w", err)
+	}
+
+	payload, err := json.Marshal(kafka.EmailSendindRequestedPayload{
+		To:      invite.Email,
+		Subject: subject,
+		Content: content,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling email sending payload: %w", err)
+	}
+	message := kafka.Message{Value: payload}
+	err = producer.Produce("email-sending-requested", message)
+	if err != nil {
+		return fmt.Errorf("error producing kafka message to send email: %w", err)
+	}
+	return nil
+}
+
+func produceProjectInviteInvitationEmail(
+	producerFactory kafka.ProducerFactory,
+	invite *model.ProjectInvite,
+	issuerName string,
+) error {
+	return produceProjectInviteEmail(
+		producerFactory,
+		invite,
+		"Trackr | You have been invited to a project",
+		"You have been invited to a project",
+		[]string{
+			fmt.Sprintf("%s has invited you to join the project %s.", issuerName, invite.Project.Name),
+			"Join the project to start collaborating with the team.",
+		},
+	)
+}
+
+// queueProjectInviteInvitationEmail loads the invite again, so it's meant to run once the invite is saved
+func queueProjectInviteInvitationEmail(
+	projectInviteRepository repository.ProjectInviteRepository,
+	producerFactory kafka.ProducerFactory,
+	inviteId string,
+	issuerName string,
+) {
+	invite, err := projectInviteRepository.FindById(inviteId)
+	if err != nil {
+		slog.Error("Unable to find invite to send email", "error", err)
+		return
+	}
+
+	err = produceProjectInviteInvitationEmail(producerFactory, invite, issuerName)
+	if err != nil {
+		slog.Error("Unable to queue project invite email", "error", err)
+	}
+}
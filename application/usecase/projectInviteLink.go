@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+func newProjectInviteLinkResponse(link *model.ProjectInviteLink) *appmodel.ProjectInviteLink {
+	return &appmodel.ProjectInviteLink{
+		ID:        link.ID,
+		ProjectID: link.ProjectID,
+		Token:     *link.Token,
+		Url:       fmt.Sprintf("%s/join-project?token=%s", config.GetAppConfig().FrontendUrl, *link.Token),
+		Role:      link.Role,
+		MaxUses:   link.MaxUses,
+		UsesCount: link.UsesCount,
+		Active:    link.IsActive(time.Now()),
+		CreatedAt: link.CreatedAt,
+		ExpiresAt: link.ExpiresAt,
+		RevokedAt: link.RevokedAt,
+	}
+}
+
+func findProjectInviteLink(
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository,
+	projectID string,
+	linkID string,
+) (*model.ProjectInviteLink, *appmodel.AppError) {
+	link, err := projectInviteLinkRepository.FindById(linkID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invite_link_not_found", "invite link not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_invite_link", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if link.ProjectID != projectID {
+		return nil, appmodel.NewAppError("invite_link_not_found", "invite link not found", appmodel.ErrorTypeValidation)
+	}
+	return link, nil
+}
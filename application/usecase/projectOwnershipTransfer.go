@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+func newProjectOwnershipTransferResponse(transfer *model.ProjectOwnershipTransfer) *appmodel.ProjectOwnershipTransfer {
+	return &appmodel.ProjectOwnershipTransfer{
+		ID:          transfer.ID,
+		ProjectID:   transfer.ProjectID,
+		FromUserID:  transfer.FromUserID,
+		ToUserID:    transfer.ToUserID,
+		Status:      transfer.Status,
+		RequestedAt: transfer.CreatedAt,
+		ExpiresAt:   transfer.ExpiresAt,
+		ConfirmedAt: transfer.ConfirmedAt,
+	}
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+const (
+	ProjectPermissionViewProject    = "view_project"
+	ProjectPermissionViewAnalytics  = "view_analytics"
+	ProjectPermissionManageApiKeys  = "manage_api_keys"
+	ProjectPermissionManageInvites  = "manage_invites"
+	ProjectPermissionManageMembers  = "manage_members"
+	ProjectPermissionManageSettings = "manage_settings"
+	ProjectPermissionDeleteProject  = "delete_project"
+	// owner only, the nominee confirms the transfer through the emailed token
+	ProjectPermissionTransferOwnership = "transfer_ownership"
+)
+
+// roles are cumulative, each one listing every permission of the role below it
+var projectRolePermissions = map[string][]string{
+	model.ProjectRoleViewer: {
+		ProjectPermissionViewProject,
+		ProjectPermissionViewAnalytics,
+	},
+	model.ProjectRoleEditor: {
+		ProjectPermissionViewProject,
+		ProjectPermissionViewAnalytics,
+		ProjectPermissionManageApiKeys,
+	},
+	model.ProjectRoleAdmin: {
+		ProjectPermissionViewProject,
+		ProjectPermissionViewAnalytics,
+		ProjectPermissionManageApiKeys,
+		ProjectPermissionManageInvites,
+		ProjectPermissionManageMembers,
+		ProjectPermissionManageSettings,
+	},
+	model.ProjectRoleOwner: {
+		ProjectPermissionViewProject,
+		ProjectPermissionViewAnalytics,
+		ProjectPermissionManageApiKeys,
+		ProjectPermissionManageInvites,
+		ProjectPermissionManageMembers,
+		ProjectPermissionManageSettings,
+		ProjectPermissionDeleteProject,
+		ProjectPermissionTransferOwnership,
+	},
+}
+
+// read api keys aren't project members, they're granted these permissions on their own project only
+var projectApiKeyPermissions = []string{ProjectPermissionViewAnalytics}
+
+// ProjectPermissionService is the single place deciding what each project member is allowed to do
+type ProjectPermissionService struct {
+	projectMemberRepository repository.ProjectMemberRepository
+}
+
+func NewProjectPermissionService(projectMemberRepository repository.ProjectMemberRepository) *ProjectPermissionService {
+	return &ProjectPermissionService{projectMemberRepository}
+}
+
+// Authorize returns the membership of the user when their role grants the permission on the project
+func (service *ProjectPermissionService) Authorize(
+	projectID string,
+	userID string,
+	permission string,
+) (*model.ProjectMember, *appmodel.AppError) {
+	member, err := service.projectMemberRepository.FindByProjectAndUser(projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError(
+				"not_project_member",
+				"only project members can access the project",
+				appmodel.ErrorTypeValidation,
+			)
+		}
+		return nil, appmodel.NewAppError("unable_to_check_membership", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if !RoleHasProjectPermission(member.Role, permission) {
+		return nil, appmodel.NewAppError(
+			"missing_project_permission",
+			fmt.Sprintf("the %s role does not have the %s permission", member.Role, permission),
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+	return member, nil
+}
+
+// AuthorizePrincipal authorizes either a read api key on its own project or a user through their membership
+func (service *ProjectPermissionService) AuthorizePrincipal(
+	projectID string,
+	principal appmodel.Principal,
+	permission string,
+) *appmodel.AppError {
+	if principal.ApiKeyID == "" {
+		_, appErr := service.Authorize(projectID, principal.UserID, permission)
+		return appErr
+	}
+
+	if principal.ApiKeyProjectID != projectID {
+		return appmodel.NewAppError(
+			"api_key_not_allowed",
+			"api key doesn't belong to the requested project",
+			appmodel.ErrorTypeAuthentication,
+		)
+	}
+
+	for _, grantedPermission := range projectApiKeyPermissions {
+		if grantedPermission == permission {
+			return nil
+		}
+	}
+	return appmodel.NewAppError(
+		"missing_project_permission",
+		fmt.Sprintf("api keys do not have the %s permission", permission),
+		appmodel.ErrorTypeForbidden,
+	)
+}
+
+func RoleHasProjectPermission(role string, permission string) bool {
+	for _, grantedPermission := range projectRolePermissions[role] {
+		if grantedPermission == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+// RefreshUserSessionUseCase trades a refresh token for a new access token and a new refresh token
+type RefreshUserSessionUseCase struct {
+	userRepository             repository.UserRepository
+	userSessionRepository      repository.UserSessionRepository
+	userRefreshTokenRepository repository.UserRefreshTokenRepository
+	jwtManager                 jwt.Manager
+}
+
+func NewRefreshUserSessionUseCase(
+	userRepository repository.UserRepository,
+	userSessionRepository repository.UserSessionRepository,
+	userRefreshTokenRepository repository.UserRefreshTokenRepository,
+	jwtManager jwt.Manager,
+) *RefreshUserSessionUseCase {
+	return &RefreshUserSessionUseCase{userRepository, userSessionRepository, userRefreshTokenRepository, jwtManager}
+}
+
+func (useCase *RefreshUserSessionUseCase) Execute(
+	req *appmodel.RefreshUserSessionRequest,
+) (*appmodel.RefreshUserSessionResponse, error) {
+	refreshToken, err := useCase.userRefreshTokenRepository.FindByTokenHash(
+		useCase.jwtManager.HashRefreshToken(req.RefreshToken),
+	)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("invalid_refresh_token", "invalid refresh token", appmodel.ErrorTypeAuthentication)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_refresh_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	session, err := useCase.userSessionRepository.FindById(refreshToken.SessionID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_find_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if refreshToken.IsUsed() {
+		return nil, revokeUserSessionFamily(useCase.userSessionRepository, session)
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) {
+		return nil, appmodel.NewAppError("revoked_session", "session expired or revoked", appmodel.ErrorTypeAuthentication)
+	}
+
+	err = refreshToken.Use(now)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_refresh_token", err.Error(), appmodel.ErrorTypeAuthentication)
+	}
+
+	user, err := useCase.userRepository.FindById(session.UserID)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_find_user", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	nextRefreshToken, plainRefreshToken, appErr := newUserRefreshToken(useCase.jwtManager, session)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	err = useCase.userRefreshTokenRepository.Rotate(refreshToken, nextRefreshToken)
+	if err != nil {
+		// a concurrent refresh used the token first
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, revokeUserSessionFamily(useCase.userSessionRepository, session)
+		}
+		return nil, appmodel.NewAppError("unable_to_save_refresh_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if session.MarkSeen(now) {
+		err = useCase.userSessionRepository.UpdateLastSeenAt(session.ID, now)
+		if err != nil {
+			slog.Error("Unable to update session last seen timestamp", "error", err)
+		}
+	}
+
+	accessToken, err := useCase.jwtManager.CreateJwtFromUser(user, session)
+	if err != nil {
+		return nil, appmodel.NewAppError("unexpected_error", err.Error(), appmodel.ErrorTypeServer)
+	}
+
+	return &appmodel.RefreshUserSessionResponse{
+		AccessToken:  accessToken,
+		RefreshToken: plainRefreshToken,
+	}, nil
+}
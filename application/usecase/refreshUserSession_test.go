@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRefreshUserSessionUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	userRefreshTokenRepositoryMock := repository.NewMockUserRefreshTokenRepository(ctrl)
+	jwtManagerMock := jwt.NewMockManager(ctrl)
+	useCase := NewRefreshUserSessionUseCase(
+		userRepositoryMock,
+		userSessionRepositoryMock,
+		userRefreshTokenRepositoryMock,
+		jwtManagerMock,
+	)
+
+	req := &model.RefreshUserSessionRequest{RefreshToken: "fake-refresh-token"}
+	jwtManagerMock.
+		EXPECT().
+		HashRefreshToken(req.RefreshToken).
+		AnyTimes().
+		Return("fake-refresh-token-hash")
+
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash("fake-refresh-token-hash").
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_refresh_token", err.(*model.AppError).Code)
+
+	user, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	session := &domainmodel.UserSession{
+		ID:         "fake-session-id",
+		UserID:     user.ID,
+		LastSeenAt: time.Now(),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	refreshToken := &domainmodel.UserRefreshToken{
+		ID:        "fake-refresh-token-id",
+		SessionID: session.ID,
+		ExpiresAt: session.ExpiresAt,
+	}
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash("fake-refresh-token-hash").
+		AnyTimes().
+		Return(refreshToken, nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById(session.ID).
+		AnyTimes().
+		Return(session, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(user.ID).
+		AnyTimes().
+		Return(user, nil)
+	jwtManagerMock.
+		EXPECT().
+		CreateRefreshToken().
+		AnyTimes().
+		Return("fake-next-refresh-token", "fake-next-refresh-token-hash", nil)
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		Rotate(refreshToken, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_refresh_token", err.(*model.AppError).Code)
+
+	refreshToken.UsedAt = nil
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		Rotate(refreshToken, gomock.Any()).
+		DoAndReturn(func(_ *domainmodel.UserRefreshToken, nextRefreshToken *domainmodel.UserRefreshToken) error {
+			assert.Equal(t, session.ID, nextRefreshToken.SessionID)
+			assert.Equal(t, "fake-next-refresh-token-hash", nextRefreshToken.TokenHash)
+			return nil
+		})
+	jwtManagerMock.
+		EXPECT().
+		CreateJwtFromUser(user, session).
+		Return("fake-access-token", nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "fake-access-token", res.AccessToken)
+	assert.Equal(t, "fake-next-refresh-token", res.RefreshToken)
+	assert.NotNil(t, refreshToken.UsedAt)
+
+	// presenting the used refresh token again revokes the whole session
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(session).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "refresh_token_reused", err.(*model.AppError).Code)
+	assert.NotNil(t, session.RevokedAt)
+
+	refreshToken.UsedAt = nil
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "revoked_session", err.(*model.AppError).Code)
+
+	// losing the race against a concurrent refresh counts as reuse too
+	session.RevokedAt = nil
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		Rotate(refreshToken, gomock.Any()).
+		Return(gorm.ErrRecordNotFound)
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(session).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "refresh_token_reused", err.(*model.AppError).Code)
+	assert.NotNil(t, session.RevokedAt)
+}
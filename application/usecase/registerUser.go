@@ -42,6 +42,19 @@ func (useCase *RegisterUserUseCase) Execute(
 		return nil, appmodel.NewAppError("invalid_data_to_register_user", err.Error(), appmodel.ErrorTypeValidation)
 	}
 
+	// the unique email constraint is case sensitive, while users are looked up by email case insensitively
+	_, err = useCase.userRepository.FindByEmail(req.Email)
+	if err == nil {
+		return nil, appmodel.NewAppError(
+			"user_email_already_used",
+			"There's already an user using this email",
+			appmodel.ErrorTypeValidation,
+		)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, appmodel.NewAppError("unable_to_register_user", "unable to register user", appmodel.ErrorTypeDatabase)
+	}
+
 	err = useCase.userRepository.Register(user)
 	if err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
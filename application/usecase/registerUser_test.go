@@ -33,6 +33,31 @@ func TestRegisterUserUseCase_Execute(t *testing.T) {
 	assert.Error(t, err, "(validation) [invalid_data_to_register_user] invalid data to register user")
 
 	req.Password = "fake-password"
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail(req.Email).
+		Return(&model.User{}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "user_email_already_used", err.Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail(req.Email).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_register_user", err.Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindByEmail(req.Email).
+		AnyTimes().
+		Return(nil, gorm.ErrRecordNotFound)
 	userRepositoryMock.
 		EXPECT().
 		Register(gomock.Any()).
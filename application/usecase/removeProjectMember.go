@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type RemoveProjectMemberUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+	projectMemberRepository  repository.ProjectMemberRepository
+	projectInviteRepository  repository.ProjectInviteRepository
+}
+
+func NewRemoveProjectMemberUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	projectMemberRepository repository.ProjectMemberRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+) *RemoveProjectMemberUseCase {
+	return &RemoveProjectMemberUseCase{
+		projectPermissionService,
+		projectRepository,
+		projectMemberRepository,
+		projectInviteRepository,
+	}
+}
+
+func (useCase *RemoveProjectMemberUseCase) Execute(req *appmodel.RemoveProjectMemberRequest) error {
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		req.ProjectID,
+		req.ActorID,
+		ProjectPermissionManageMembers,
+	)
+	if appErr != nil {
+		return appErr
+	}
+
+	if req.MemberID == req.ActorID {
+		return appmodel.NewAppError(
+			"unable_to_remove_self",
+			"members should leave the project instead of removing themselves",
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	member := project.FindMembership(req.MemberID)
+	if member == nil {
+		return appmodel.NewAppError("project_member_not_found", "project member not found", appmodel.ErrorTypeValidation)
+	}
+
+	// the owner can't be assigned by anyone, so it is also covered here
+	if !actorMembership.CanAssignRole(member.Role) {
+		return appmodel.NewAppError(
+			"member_not_removable",
+			fmt.Sprintf("the %s role can't remove %s members", actorMembership.Role, member.Role),
+			appmodel.ErrorTypeForbidden,
+		)
+	}
+
+	err = project.RemoveMember(member.UserID)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_remove_member", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	return deleteProjectMembership(useCase.projectMemberRepository, useCase.projectInviteRepository, member)
+}
+
+// deleteProjectMembership revokes the pending invites issued by the member before deleting the membership,
+// so a failure can be retried without leaving invites from someone who is no longer part of the project
+func deleteProjectMembership(
+	projectMemberRepository repository.ProjectMemberRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+	member *model.ProjectMember,
+) error {
+	err := projectInviteRepository.DeletePendingByProjectAndInviter(member.ProjectID, member.UserID)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_delete_member_invites", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	err = projectMemberRepository.Delete(member)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_delete_project_member", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
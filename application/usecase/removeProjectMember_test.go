@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRemoveProjectMemberUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	useCase := NewRemoveProjectMemberUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		projectMemberRepositoryMock,
+		projectInviteRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	admin, _ := factory.NewVerifiedUser("admin@gmail.com", "Admin", "fake-password")
+	_ = project.AddMember(admin, domainmodel.ProjectRoleAdmin)
+	editor, _ := factory.NewVerifiedUser("editor@gmail.com", "Editor", "fake-password")
+	_ = project.AddMember(editor, domainmodel.ProjectRoleEditor)
+
+	req := &model.RemoveProjectMemberRequest{
+		ActorID:   editor.ID,
+		ProjectID: project.ID,
+		MemberID:  admin.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, editor.ID).
+		Return(project.FindMembership(editor.ID), nil)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
+
+	req.ActorID = admin.ID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, admin.ID).
+		AnyTimes().
+		Return(project.FindMembership(admin.ID), nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_remove_self", err.(*model.AppError).Code)
+
+	req.MemberID = editor.ID
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_not_found", err.(*model.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_project", err.(*model.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		AnyTimes().
+		Return(project, nil)
+
+	req.MemberID = "fake-member-id"
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_member_not_found", err.(*model.AppError).Code)
+
+	req.MemberID = project.OwnerID
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "member_not_removable", err.(*model.AppError).Code)
+	assert.Equal(t, model.ErrorTypeForbidden, err.(*model.AppError).Type)
+
+	req.MemberID = editor.ID
+	projectInviteRepositoryMock.
+		EXPECT().
+		DeletePendingByProjectAndInviter(project.ID, editor.ID).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_delete_member_invites", err.(*model.AppError).Code)
+
+	// the repository would load the member again on the next attempt
+	_ = project.AddMember(editor, domainmodel.ProjectRoleEditor)
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		DeletePendingByProjectAndInviter(project.ID, editor.ID).
+		AnyTimes().
+		Return(nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		Delete(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_delete_project_member", err.(*model.AppError).Code)
+
+	_ = project.AddMember(editor, domainmodel.ProjectRoleEditor)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		Delete(gomock.Any()).
+		DoAndReturn(func(member *domainmodel.ProjectMember) error {
+			assert.Equal(t, project.ID, member.ProjectID)
+			assert.Equal(t, editor.ID, member.UserID)
+			return nil
+		})
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/RuanScherer/journey-track-api/adapters/emailtemplateadptr"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/matcornic/hermes/v2"
+	"gorm.io/gorm"
+)
+
+type RequestProjectOwnershipTransferUseCase struct {
+	projectPermissionService           *ProjectPermissionService
+	projectRepository                  repository.ProjectRepository
+	userRepository                     repository.UserRepository
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository
+	producerFactory                    kafka.ProducerFactory
+}
+
+func NewRequestProjectOwnershipTransferUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+	userRepository repository.UserRepository,
+	projectOwnershipTransferRepository repository.ProjectOwnershipTransferRepository,
+	producerFactory kafka.ProducerFactory,
+) *RequestProjectOwnershipTransferUseCase {
+	return &RequestProjectOwnershipTransferUseCase{
+		projectPermissionService,
+		projectRepository,
+		userRepository,
+		projectOwnershipTransferRepository,
+		producerFactory,
+	}
+}
+
+func (useCase *RequestProjectOwnershipTransferUseCase) Execute(
+	req *appmodel.RequestProjectOwnershipTransferRequest,
+) (*appmodel.ProjectOwnershipTransfer, error) {
+	_, appErr := useCase.projectPermissionService.Authorize(
+		req.ProjectID,
+		req.ActorID,
+		ProjectPermissionTransferOwnership,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	nominee, err := useCase.userRepository.FindById(req.NomineeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("user_not_found", "user not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_user", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	transfer, err := model.NewProjectOwnershipTransfer(project, nominee)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_request_ownership_transfer",
+			err.Error(),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	appErr = useCase.cancelPendingTransfer(project.ID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	err = useCase.projectOwnershipTransferRepository.Create(transfer)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_ownership_transfer", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	go useCase.queueOwnershipTransferEmail(transfer.ID)
+	return newProjectOwnershipTransferResponse(transfer), nil
+}
+
+// cancelPendingTransfer keeps a single pending transfer per project, so nominating someone else replaces it
+func (useCase *RequestProjectOwnershipTransferUseCase) cancelPendingTransfer(projectID string) *appmodel.AppError {
+	pendingTransfer, err := useCase.projectOwnershipTransferRepository.FindPendingByProject(projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return appmodel.NewAppError(
+			"unable_to_check_pending_ownership_transfer",
+			err.Error(),
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	err = pendingTransfer.Cancel()
+	if err != nil {
+		return appmodel.NewAppError("unable_to_cancel_ownership_transfer", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectOwnershipTransferRepository.Save(pendingTransfer)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_cancel_ownership_transfer", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
+
+func (useCase *RequestProjectOwnershipTransferUseCase) queueOwnershipTransferEmail(transferId string) {
+	transfer, err := useCase.projectOwnershipTransferRepository.FindById(transferId)
+	if err != nil {
+		slog.Error("Unable to find ownership transfer to send email", "error", err)
+		return
+	}
+
+	appConfig := config.GetAppConfig()
+	confirmTransferLink := fmt.Sprintf(
+		"%s/confirm-ownership-transfer?projectId=%s&token=%s",
+		appConfig.FrontendUrl,
+		transfer.ProjectID,
+		*transfer.Token,
+	)
+
+	emailTemplate := hermes.Email{
+		Body: hermes.Body{
+			Name:  transfer.ToUser.Name,
+			Title: "You have been nominated as project owner",
+			Intros: []string{
+				fmt.Sprintf(
+					"%s wants to transfer the ownership of the project %s to you.",
+					transfer.FromUser.Name,
+					transfer.Project.Name,
+				),
+				"Once you confirm, you will be the only one able to delete the project or transfer it again.",
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "Click the button below to confirm the transfer.",
+					Button: hermes.Button{
+						Color: "#f25d9c",
+						Text:  "Confirm transfer",
+						Link:  confirmTransferLink,
+					},
+				},
+			},
+			Outros: []string{
+				fmt.Sprintf("This link expires on %s.", transfer.ExpiresAt.Format("January 2, 2006")),
+			},
+			Signature: "Regards",
+		},
+	}
+	content, err := emailtemplateadptr.GenerateEmailHtml(emailTemplate)
+	if err != nil {
+		slog.Error("Error generating ownership transfer email", "error", err)
+		return
+	}
+
+	producer, err := useCase.producerFactory.NewProducer(map[string]any{
+		"bootstrap.servers": appConfig.KafkaBootstrapServers,
+		"retries":           3,
+		"retry.backoff.ms":  1000,
+	})
+	if err != nil {
+		slog.Error("Error setting kafka producer config", "error", err)
+		return
+	}
+
+	payload, err := json.Marshal(kafka.EmailSendindRequestedPayload{
+		To:      *transfer.ToUser.Email,
+		Subject: "Trackr | Confirm the project ownership transfer",
+		Content: content,
+	})
+	if err != nil {
+		slog.Error("Error marshalling email sending payload", "error", err)
+		return
+	}
+	message := kafka.Message{Value: payload}
+	err = producer.Produce("email-sending-requested", message)
+	if err != nil {
+		slog.Error("Error producing kafka message to send email", "error", err)
+		return
+	}
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRequestProjectOwnershipTransferUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectOwnershipTransferRepositoryMock := repository.NewMockProjectOwnershipTransferRepository(ctrl)
+	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	useCase := NewRequestProjectOwnershipTransferUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectRepositoryMock,
+		userRepositoryMock,
+		projectOwnershipTransferRepositoryMock,
+		producerFactoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	admin, _ := factory.NewVerifiedUser("admin@gmail.com", "Admin", "fake-password")
+	_ = project.AddMember(admin, domainmodel.ProjectRoleAdmin)
+	outsider, _ := factory.NewVerifiedUser("outsider@gmail.com", "Outsider", "fake-password")
+
+	req := &model.RequestProjectOwnershipTransferRequest{
+		ActorID:   admin.ID,
+		ProjectID: project.ID,
+		NomineeID: outsider.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, admin.ID).
+		Return(project.FindMembership(admin.ID), nil)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
+
+	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_project", err.(*model.AppError).Code)
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(project.ID).
+		AnyTimes().
+		Return(project, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(outsider.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "user_not_found", err.(*model.AppError).Code)
+
+	userRepositoryMock.
+		EXPECT().
+		FindById(outsider.ID).
+		Return(outsider, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_request_ownership_transfer", err.(*model.AppError).Code)
+
+	req.NomineeID = admin.ID
+	userRepositoryMock.
+		EXPECT().
+		FindById(admin.ID).
+		AnyTimes().
+		Return(admin, nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindPendingByProject(project.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_check_pending_ownership_transfer", err.(*model.AppError).Code)
+
+	pendingTransfer, _ := domainmodel.NewProjectOwnershipTransfer(project, admin)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindPendingByProject(project.ID).
+		Return(pendingTransfer, nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		Save(pendingTransfer).
+		Return(nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_ownership_transfer", err.(*model.AppError).Code)
+	assert.Equal(t, domainmodel.ProjectOwnershipTransferStatusCancelled, pendingTransfer.Status)
+
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindPendingByProject(project.ID).
+		Return(nil, gorm.ErrRecordNotFound)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(nil)
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindById(gomock.Any()).
+		AnyTimes().
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, project.OwnerID, res.FromUserID)
+	assert.Equal(t, admin.ID, res.ToUserID)
+	assert.Equal(t, domainmodel.ProjectOwnershipTransferStatusPending, res.Status)
+}
+
+func TestRequestProjectOwnershipTransferUseCase_queueOwnershipTransferEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectOwnershipTransferRepositoryMock := repository.NewMockProjectOwnershipTransferRepository(ctrl)
+	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	useCase := NewRequestProjectOwnershipTransferUseCase(
+		NewProjectPermissionService(repository.NewMockProjectMemberRepository(ctrl)),
+		repository.NewMockProjectRepository(ctrl),
+		repository.NewMockUserRepository(ctrl),
+		projectOwnershipTransferRepositoryMock,
+		producerFactoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	nominee, _ := factory.NewVerifiedUser("john.doe@gmail.com", "John Doe", "fake-password")
+	_ = project.AddMember(nominee, domainmodel.ProjectRoleAdmin)
+	transfer, _ := domainmodel.NewProjectOwnershipTransfer(project, nominee)
+	transfer.FromUser = project.Memberships[0].User
+
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindById(transfer.ID).
+		Return(nil, errors.New("unexpected error"))
+
+	useCase.queueOwnershipTransferEmail(transfer.ID)
+
+	projectOwnershipTransferRepositoryMock.
+		EXPECT().
+		FindById(transfer.ID).
+		Return(transfer, nil)
+
+	producerMock := kafka.NewMockProducer(ctrl)
+	producerFactoryMock.
+		EXPECT().
+		NewProducer(gomock.Any()).
+		Return(producerMock, nil)
+	producerMock.
+		EXPECT().
+		Produce("email-sending-requested", gomock.Any()).
+		Return(nil)
+
+	useCase.queueOwnershipTransferEmail(transfer.ID)
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+type ResendProjectInviteUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	userRepository           repository.UserRepository
+	projectInviteRepository  repository.ProjectInviteRepository
+	producerFactory          kafka.ProducerFactory
+}
+
+func NewResendProjectInviteUseCase(
+	projectPermissionService *ProjectPermissionService,
+	userRepository repository.UserRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+	producerFactory kafka.ProducerFactory,
+) *ResendProjectInviteUseCase {
+	return &ResendProjectInviteUseCase{
+		projectPermissionService,
+		userRepository,
+		projectInviteRepository,
+		producerFactory,
+	}
+}
+
+func (useCase *ResendProjectInviteUseCase) Execute(
+	req *appmodel.ResendProjectInviteRequest,
+) (*appmodel.ProjectInvite, error) {
+	projectInvite, err := useCase.projectInviteRepository.FindById(req.ProjectInviteID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_invite_not_found", "project invite not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project_invite", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	_, appErr := useCase.projectPermissionService.Authorize(
+		projectInvite.ProjectID,
+		req.ActorID,
+		ProjectPermissionManageInvites,
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	actor, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to resend the invite",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	err = projectInvite.Renew(time.Now())
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_resend_project_invite", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectInviteRepository.Save(projectInvite)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_project_invite", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	go useCase.queueProjectInviteEmail(projectInvite, actor.Name)
+
+	return newProjectInviteResponse(projectInvite), nil
+}
+
+func (useCase *ResendProjectInviteUseCase) queueProjectInviteEmail(invite *model.ProjectInvite, issuerName string) {
+	err := produceProjectInviteInvitationEmail(useCase.producerFactory, invite, issuerName)
+	if err != nil {
+		slog.Error("Unable to queue project invite email", "error", err)
+	}
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestResendProjectInviteUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	useCase := NewResendProjectInviteUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		userRepositoryMock,
+		projectInviteRepositoryMock,
+		producerFactoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	owner := project.Memberships[0].User
+	user, _ := factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, owner)
+
+	req := &appmodel.ResendProjectInviteRequest{
+		ActorID:         owner.ID,
+		ProjectInviteID: invitation.ID,
+	}
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectInviteID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project_invite_not_found", err.(*appmodel.AppError).Code)
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectInviteID).
+		AnyTimes().
+		Return(invitation, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, owner.ID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    owner.ID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, owner.ID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(owner.ID).
+		AnyTimes().
+		Return(owner, nil)
+
+	invitation.Status = domainmodel.ProjectInviteStatusAccepted
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_resend_project_invite", err.(*appmodel.AppError).Code)
+
+	invitation.Status = domainmodel.ProjectInviteStatusExpired
+	projectInviteRepositoryMock.
+		EXPECT().
+		Save(invitation).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_project_invite", err.(*appmodel.AppError).Code)
+
+	previousToken := *invitation.Token
+	projectInviteRepositoryMock.
+		EXPECT().
+		Save(invitation).
+		Return(nil)
+	producerFactoryMock.
+		EXPECT().
+		NewProducer(gomock.Any()).
+		AnyTimes().
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, domainmodel.ProjectInviteStatusPending, res.Status)
+	assert.Equal(t, invitation.ExpiresAt, res.ExpiresAt)
+	assert.NotEqual(t, previousToken, *invitation.Token)
+}
@@ -1,16 +1,24 @@
 package usecase
 
 import (
+	"time"
+
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
 )
 
 type ResetUserPasswordUseCase struct {
-	userRepository repository.UserRepository
+	userRepository                repository.UserRepository
+	userSessionRepository         repository.UserSessionRepository
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
 }
 
-func NewResetUserPasswordUseCase(userRepository repository.UserRepository) *ResetUserPasswordUseCase {
-	return &ResetUserPasswordUseCase{userRepository}
+func NewResetUserPasswordUseCase(
+	userRepository repository.UserRepository,
+	userSessionRepository repository.UserSessionRepository,
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+) *ResetUserPasswordUseCase {
+	return &ResetUserPasswordUseCase{userRepository, userSessionRepository, personalAccessTokenRepository}
 }
 
 func (useCase *ResetUserPasswordUseCase) Execute(req *appmodel.PasswordResetRequest) error {
@@ -36,5 +44,17 @@ func (useCase *ResetUserPasswordUseCase) Execute(req *appmodel.PasswordResetRequ
 		)
 	}
 
+	// whoever knew the previous password may still be signed in or hold tokens created meanwhile
+	now := time.Now()
+	err = useCase.userSessionRepository.RevokeAllByUser(u.ID, now)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_sessions", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	err = useCase.personalAccessTokenRepository.RevokeAllByUser(u.ID, now)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_tokens", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
 	return nil
 }
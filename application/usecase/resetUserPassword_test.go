@@ -13,7 +13,13 @@ import (
 func TestResetUserPasswordUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
-	useCase := NewResetUserPasswordUseCase(userRepositoryMock)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	personalAccessTokenRepositoryMock := repository.NewMockPersonalAccessTokenRepository(ctrl)
+	useCase := NewResetUserPasswordUseCase(
+		userRepositoryMock,
+		userSessionRepositoryMock,
+		personalAccessTokenRepositoryMock,
+	)
 
 	req := &appmodel.PasswordResetRequest{
 		UserID:             "fake-user-id",
@@ -66,6 +72,38 @@ func TestResetUserPasswordUseCase_Execute(t *testing.T) {
 	userRepositoryMock.
 		EXPECT().
 		Save(user).
+		AnyTimes().
+		Return(nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		RevokeAllByUser(user.ID, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_revoke_sessions", err.(*appmodel.AppError).Code)
+
+	user.RequestPasswordReset()
+	req.PasswordResetToken = *user.PasswordResetToken
+	userSessionRepositoryMock.
+		EXPECT().
+		RevokeAllByUser(user.ID, gomock.Any()).
+		AnyTimes().
+		Return(nil)
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		RevokeAllByUser(user.ID, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_revoke_tokens", err.(*appmodel.AppError).Code)
+
+	user.RequestPasswordReset()
+	req.PasswordResetToken = *user.PasswordResetToken
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		RevokeAllByUser(user.ID, gomock.Any()).
 		Return(nil)
 
 	err = useCase.Execute(req)
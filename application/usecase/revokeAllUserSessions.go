@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+// RevokeAllUserSessionsUseCase signs the user out everywhere, including the session making the request
+type RevokeAllUserSessionsUseCase struct {
+	userSessionRepository repository.UserSessionRepository
+}
+
+func NewRevokeAllUserSessionsUseCase(userSessionRepository repository.UserSessionRepository) *RevokeAllUserSessionsUseCase {
+	return &RevokeAllUserSessionsUseCase{userSessionRepository}
+}
+
+func (useCase *RevokeAllUserSessionsUseCase) Execute(req *appmodel.RevokeAllUserSessionsRequest) error {
+	err := useCase.userSessionRepository.RevokeAllByUser(req.ActorID, time.Now())
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_sessions", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type RevokePersonalAccessTokenUseCase struct {
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+}
+
+func NewRevokePersonalAccessTokenUseCase(
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository,
+) *RevokePersonalAccessTokenUseCase {
+	return &RevokePersonalAccessTokenUseCase{personalAccessTokenRepository}
+}
+
+func (useCase *RevokePersonalAccessTokenUseCase) Execute(req *appmodel.RevokePersonalAccessTokenRequest) error {
+	token, appErr := findPersonalAccessToken(useCase.personalAccessTokenRepository, req.ActorID, req.TokenID)
+	if appErr != nil {
+		return appErr
+	}
+
+	err := token.Revoke()
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_token", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.personalAccessTokenRepository.Save(token)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_save_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRevokePersonalAccessTokenUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	personalAccessTokenRepositoryMock := repository.NewMockPersonalAccessTokenRepository(ctrl)
+	useCase := NewRevokePersonalAccessTokenUseCase(personalAccessTokenRepositoryMock)
+
+	req := &model.RevokePersonalAccessTokenRequest{
+		ActorID: "fake-actor-id",
+		TokenID: "fake-token-id",
+	}
+
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		FindById(req.TokenID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "token_not_found", err.(*model.AppError).Code)
+
+	token := &domainmodel.PersonalAccessToken{
+		ID:        req.TokenID,
+		UserID:    "fake-other-user-id",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		FindById(req.TokenID).
+		AnyTimes().
+		Return(token, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "token_not_found", err.(*model.AppError).Code)
+
+	token.UserID = req.ActorID
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		Save(token).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_token", err.(*model.AppError).Code)
+
+	token.RevokedAt = nil
+	personalAccessTokenRepositoryMock.
+		EXPECT().
+		Save(token).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, token.RevokedAt)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_revoke_token", err.(*model.AppError).Code)
+}
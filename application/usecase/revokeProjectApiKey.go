@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type RevokeProjectApiKeyUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectApiKeyRepository  repository.ProjectApiKeyRepository
+}
+
+func NewRevokeProjectApiKeyUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *RevokeProjectApiKeyUseCase {
+	return &RevokeProjectApiKeyUseCase{projectPermissionService, projectApiKeyRepository}
+}
+
+func (useCase *RevokeProjectApiKeyUseCase) Execute(req *appmodel.RevokeProjectApiKeyRequest) error {
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageApiKeys)
+	if appErr != nil {
+		return appErr
+	}
+
+	apiKey, appErr := findProjectApiKey(useCase.projectApiKeyRepository, req.ProjectID, req.ApiKeyID)
+	if appErr != nil {
+		return appErr
+	}
+
+	err := apiKey.Revoke()
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_api_key", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectApiKeyRepository.Save(apiKey)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_save_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
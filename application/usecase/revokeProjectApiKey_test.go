@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRevokeProjectApiKeyUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewRevokeProjectApiKeyUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectApiKeyRepositoryMock)
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	req := &appmodel.RevokeProjectApiKeyRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: apiKey.ProjectID,
+		ApiKeyID:  apiKey.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(nil, errors.New("unexpected error"))
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_check_membership]: unexpected error")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindById(req.ApiKeyID).
+		AnyTimes().
+		Return(apiKey, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Save(apiKey).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, apiKey.RevokedAt)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [unable_to_revoke_api_key]: [project api key] Api key already revoked")
+}
@@ -8,17 +8,17 @@ import (
 )
 
 type RevokeProjectInviteUseCase struct {
-	projectInviteRepository repository2.ProjectInviteRepository
-	userRepository          repository2.UserRepository
+	projectInviteRepository  repository2.ProjectInviteRepository
+	projectPermissionService *ProjectPermissionService
 }
 
 func NewRevokeProjectInviteUseCase(
 	projectInviteRepository repository2.ProjectInviteRepository,
-	userRepository repository2.UserRepository,
+	projectPermissionService *ProjectPermissionService,
 ) *RevokeProjectInviteUseCase {
 	return &RevokeProjectInviteUseCase{
 		projectInviteRepository,
-		userRepository,
+		projectPermissionService,
 	}
 }
 
@@ -40,22 +40,13 @@ func (useCase *RevokeProjectInviteUseCase) Execute(req *appmodel.RevokeProjectIn
 		)
 	}
 
-	actor, err := useCase.userRepository.FindById(req.ActorID)
-	if err != nil {
-		return appmodel.NewAppError(
-			"unable_to_identify_user",
-			"unable to identify the user trying to revoke the invite",
-			appmodel.ErrorTypeDatabase,
-		)
-	}
-
-	isActorProjectMember := projectInvite.Project.HasMember(actor)
-	if !isActorProjectMember {
-		return appmodel.NewAppError(
-			"not_project_member",
-			"only project members can revoke invites",
-			appmodel.ErrorTypeValidation,
-		)
+	_, appErr := useCase.projectPermissionService.Authorize(
+		projectInvite.ProjectID,
+		req.ActorID,
+		ProjectPermissionManageInvites,
+	)
+	if appErr != nil {
+		return appErr
 	}
 
 	err = useCase.projectInviteRepository.DeleteById(req.ProjectInviteID)
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type RevokeProjectInviteLinkUseCase struct {
+	projectPermissionService    *ProjectPermissionService
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository
+}
+
+func NewRevokeProjectInviteLinkUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectInviteLinkRepository repository.ProjectInviteLinkRepository,
+) *RevokeProjectInviteLinkUseCase {
+	return &RevokeProjectInviteLinkUseCase{projectPermissionService, projectInviteLinkRepository}
+}
+
+func (useCase *RevokeProjectInviteLinkUseCase) Execute(req *appmodel.RevokeProjectInviteLinkRequest) error {
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageInvites)
+	if appErr != nil {
+		return appErr
+	}
+
+	link, appErr := findProjectInviteLink(useCase.projectInviteLinkRepository, req.ProjectID, req.InviteLinkID)
+	if appErr != nil {
+		return appErr
+	}
+
+	err := link.Revoke()
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_invite_link", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectInviteLinkRepository.Save(link)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_save_invite_link", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
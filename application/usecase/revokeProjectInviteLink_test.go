@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRevokeProjectInviteLinkUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	projectInviteLinkRepositoryMock := repository.NewMockProjectInviteLinkRepository(ctrl)
+	useCase := NewRevokeProjectInviteLinkUseCase(
+		NewProjectPermissionService(projectMemberRepositoryMock),
+		projectInviteLinkRepositoryMock,
+	)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	link, _ := domainmodel.NewProjectInviteLink(
+		project,
+		project.Memberships[0],
+		domainmodel.ProjectRoleViewer,
+		nil,
+		time.Now().Add(time.Hour),
+	)
+	req := &appmodel.RevokeProjectInviteLinkRequest{
+		ActorID:      project.OwnerID,
+		ProjectID:    "another-project-id",
+		InviteLinkID: link.ID,
+	}
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(gomock.Any(), req.ActorID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindById(req.InviteLinkID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invite_link_not_found", err.(*appmodel.AppError).Code)
+
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		FindById(req.InviteLinkID).
+		AnyTimes().
+		Return(link, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invite_link_not_found", err.(*appmodel.AppError).Code)
+
+	req.ProjectID = project.ID
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Save(link).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_invite_link", err.(*appmodel.AppError).Code)
+
+	link.RevokedAt = nil
+	projectInviteLinkRepositoryMock.
+		EXPECT().
+		Save(link).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, link.RevokedAt)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_revoke_invite_link", err.(*appmodel.AppError).Code)
+}
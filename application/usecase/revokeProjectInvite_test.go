@@ -15,8 +15,11 @@ import (
 func TestRevokeProjectInviteUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	projectInviteMockRepository := repository.NewMockProjectInviteRepository(ctrl)
-	userMockRepository := repository.NewMockUserRepository(ctrl)
-	useCase := NewRevokeProjectInviteUseCase(projectInviteMockRepository, userMockRepository)
+	projectMemberMockRepository := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewRevokeProjectInviteUseCase(
+		projectInviteMockRepository,
+		NewProjectPermissionService(projectMemberMockRepository),
+	)
 
 	req := &appmodel.RevokeProjectInviteRequest{
 		ActorID:         "fake-actor-id",
@@ -43,7 +46,7 @@ func TestRevokeProjectInviteUseCase_Execute(t *testing.T) {
 
 	project, _ := factory.NewProjectWithDefaultOwner("fake project")
 	user, _ := factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ := domainmodel.NewProjectInvite(project, user)
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	_ = invitation.Accept(*invitation.Token)
 	projectInviteMockRepository.
 		EXPECT().
@@ -56,49 +59,65 @@ func TestRevokeProjectInviteUseCase_Execute(t *testing.T) {
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake project")
 	user, _ = factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteMockRepository.
 		EXPECT().
 		FindById(req.ProjectInviteID).
 		Return(invitation, nil)
-	userMockRepository.
+	projectMemberMockRepository.
 		EXPECT().
-		FindById(req.ActorID).
+		FindByProjectAndUser(project.ID, req.ActorID).
 		Return(nil, errors.New("unexpected error"))
 
 	err = useCase.Execute(req)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [unable_to_identify_user] unable to identify the user trying to revoke the invite")
+	assert.Equal(t, "unable_to_check_membership", err.(*appmodel.AppError).Code)
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake project")
 	user, _ = factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
-	randomUser, _ := factory.NewVerifiedUser("random@gmail.com", "random user", "fake-password")
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteMockRepository.
 		EXPECT().
 		FindById(req.ProjectInviteID).
 		Return(invitation, nil)
-	userMockRepository.
+	projectMemberMockRepository.
 		EXPECT().
-		FindById(req.ActorID).
-		Return(randomUser, nil)
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "not_project_member", err.(*appmodel.AppError).Code)
+
+	projectInviteMockRepository.
+		EXPECT().
+		FindById(req.ProjectInviteID).
+		Return(invitation, nil)
+	projectMemberMockRepository.
+		EXPECT().
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
 
 	err = useCase.Execute(req)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [not_project_member] only project members can revoke invites")
+	assert.Equal(t, "missing_project_permission", err.(*appmodel.AppError).Code)
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake project")
 	user, _ = factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
-	req.ActorID = project.Members[0].ID
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
+	req.ActorID = project.Memberships[0].User.ID
 	projectInviteMockRepository.
 		EXPECT().
 		FindById(req.ProjectInviteID).
 		Return(invitation, nil)
-	userMockRepository.
+	projectMemberMockRepository.
 		EXPECT().
-		FindById(project.Members[0].ID).
-		Return(project.Members[0], nil)
+		FindByProjectAndUser(project.ID, project.Memberships[0].User.ID).
+		Return(project.Memberships[0], nil)
 	projectInviteMockRepository.
 		EXPECT().
 		DeleteById(req.ProjectInviteID).
@@ -110,16 +129,16 @@ func TestRevokeProjectInviteUseCase_Execute(t *testing.T) {
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake project")
 	user, _ = factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ = domainmodel.NewProjectInvite(project, user)
-	req.ActorID = project.Members[0].ID
+	invitation, _ = domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
+	req.ActorID = project.Memberships[0].User.ID
 	projectInviteMockRepository.
 		EXPECT().
 		FindById(req.ProjectInviteID).
 		Return(invitation, nil)
-	userMockRepository.
+	projectMemberMockRepository.
 		EXPECT().
-		FindById(project.Members[0].ID).
-		Return(project.Members[0], nil)
+		FindByProjectAndUser(project.ID, project.Memberships[0].User.ID).
+		Return(project.Memberships[0], nil)
 	projectInviteMockRepository.
 		EXPECT().
 		DeleteById(req.ProjectInviteID).
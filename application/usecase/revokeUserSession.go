@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+type RevokeUserSessionUseCase struct {
+	userSessionRepository repository.UserSessionRepository
+}
+
+func NewRevokeUserSessionUseCase(userSessionRepository repository.UserSessionRepository) *RevokeUserSessionUseCase {
+	return &RevokeUserSessionUseCase{userSessionRepository}
+}
+
+func (useCase *RevokeUserSessionUseCase) Execute(req *appmodel.RevokeUserSessionRequest) error {
+	session, appErr := findUserSession(useCase.userSessionRepository, req.ActorID, req.SessionID)
+	if appErr != nil {
+		return appErr
+	}
+
+	err := session.Revoke(time.Now())
+	if err != nil {
+		return appmodel.NewAppError("unable_to_revoke_session", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.userSessionRepository.Save(session)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_save_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRevokeUserSessionUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	useCase := NewRevokeUserSessionUseCase(userSessionRepositoryMock)
+
+	req := &model.RevokeUserSessionRequest{
+		ActorID:   "fake-actor-id",
+		SessionID: "fake-session-id",
+	}
+
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById(req.SessionID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err := useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "session_not_found", err.(*model.AppError).Code)
+
+	session := &domainmodel.UserSession{
+		ID:        req.SessionID,
+		UserID:    "fake-other-user-id",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById(req.SessionID).
+		AnyTimes().
+		Return(session, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "session_not_found", err.(*model.AppError).Code)
+
+	session.UserID = req.ActorID
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(session).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_session", err.(*model.AppError).Code)
+
+	session.RevokedAt = nil
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(session).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, session.RevokedAt)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_revoke_session", err.(*model.AppError).Code)
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+)
+
+const (
+	DefaultProjectApiKeyRotationGracePeriodHours = 24
+	MaxProjectApiKeyRotationGracePeriodHours     = 7 * 24
+)
+
+type RotateProjectApiKeyUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	userRepository           repository.UserRepository
+	projectApiKeyRepository  repository.ProjectApiKeyRepository
+}
+
+func NewRotateProjectApiKeyUseCase(
+	projectPermissionService *ProjectPermissionService,
+	userRepository repository.UserRepository,
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+) *RotateProjectApiKeyUseCase {
+	return &RotateProjectApiKeyUseCase{projectPermissionService, userRepository, projectApiKeyRepository}
+}
+
+func (useCase *RotateProjectApiKeyUseCase) Execute(
+	req *appmodel.RotateProjectApiKeyRequest,
+) (*appmodel.RotateProjectApiKeyResponse, error) {
+	gracePeriodHours := DefaultProjectApiKeyRotationGracePeriodHours
+	if req.GracePeriodHours != nil {
+		gracePeriodHours = *req.GracePeriodHours
+	}
+	if gracePeriodHours < 0 || gracePeriodHours > MaxProjectApiKeyRotationGracePeriodHours {
+		return nil, appmodel.NewAppError(
+			"invalid_grace_period",
+			fmt.Sprintf("grace period should be between 0 and %d hours", MaxProjectApiKeyRotationGracePeriodHours),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	_, appErr := useCase.projectPermissionService.Authorize(req.ProjectID, req.ActorID, ProjectPermissionManageApiKeys)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	apiKey, appErr := findProjectApiKey(useCase.projectApiKeyRepository, req.ProjectID, req.ApiKeyID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	actor, err := useCase.userRepository.FindById(req.ActorID)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_identify_user",
+			"unable to identify the user trying to rotate the api key",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	rotatedApiKey, plainKey, err := apiKey.Rotate(time.Duration(gracePeriodHours)*time.Hour, actor)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_rotate_api_key", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	err = useCase.projectApiKeyRepository.Register(rotatedApiKey)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	err = useCase.projectApiKeyRepository.Save(apiKey)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_api_key", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	return &appmodel.RotateProjectApiKeyResponse{
+		ApiKey: newProjectApiKeyResponse(rotatedApiKey),
+		Key:    plainKey,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRotateProjectApiKeyUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectApiKeyRepositoryMock := repository.NewMockProjectApiKeyRepository(ctrl)
+	useCase := NewRotateProjectApiKeyUseCase(NewProjectPermissionService(projectMemberRepositoryMock), userRepositoryMock, projectApiKeyRepositoryMock)
+
+	invalidGracePeriodHours := MaxProjectApiKeyRotationGracePeriodHours + 1
+	req := &appmodel.RotateProjectApiKeyRequest{
+		ActorID:          "fake-actor-id",
+		ProjectID:        "fake-project-id",
+		ApiKeyID:         "fake-api-key-id",
+		GracePeriodHours: &invalidGracePeriodHours,
+	}
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid_grace_period", err.(*appmodel.AppError).Code)
+
+	req.GracePeriodHours = nil
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [not_project_member]: only project members can manage api keys")
+
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindById(req.ApiKeyID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [api_key_not_found]: api key not found")
+
+	otherProjectApiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeRead)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindById(req.ApiKeyID).
+		Return(otherProjectApiKey, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [api_key_not_found]: api key not found")
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeRead)
+	req.ProjectID = apiKey.ProjectID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(req.ProjectID, req.ActorID).
+		AnyTimes().
+		Return(&domainmodel.ProjectMember{
+			ProjectID: req.ProjectID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		FindById(req.ApiKeyID).
+		AnyTimes().
+		Return(apiKey, nil)
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.ActorID).
+		AnyTimes().
+		Return(apiKey.Project.Memberships[0].User, nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_save_api_key]: unexpected error")
+
+	apiKey.ExpiresAt = nil
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(nil)
+	projectApiKeyRepositoryMock.
+		EXPECT().
+		Save(apiKey).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.NotEqual(t, apiKey.ID, res.ApiKey.ID)
+	assert.Equal(t, apiKey.Name, res.ApiKey.Name)
+	assert.NotEmpty(t, res.Key)
+	assert.WithinDuration(
+		t,
+		time.Now().Add(DefaultProjectApiKeyRotationGracePeriodHours*time.Hour),
+		*apiKey.ExpiresAt,
+		time.Minute,
+	)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"errors"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+type RotateProjectSigningSecretUseCase struct {
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
+}
+
+func NewRotateProjectSigningSecretUseCase(
+	projectPermissionService *ProjectPermissionService,
+	projectRepository repository.ProjectRepository,
+) *RotateProjectSigningSecretUseCase {
+	return &RotateProjectSigningSecretUseCase{projectPermissionService, projectRepository}
+}
+
+func (useCase *RotateProjectSigningSecretUseCase) Execute(
+	req *appmodel.RotateProjectSigningSecretRequest,
+) (*appmodel.RotateProjectSigningSecretResponse, error) {
+	project, err := useCase.projectRepository.FindById(req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	_, appErr := useCase.projectPermissionService.Authorize(project.ID, req.ActorID, ProjectPermissionManageSettings)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	signingSecret, err := project.RotateSigningSecret()
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_rotate_signing_secret", err.Error(), appmodel.ErrorTypeServer)
+	}
+
+	err = useCase.projectRepository.Save(project)
+	if err != nil {
+		return nil, appmodel.NewAppError(
+			"unable_to_save_project_changes",
+			"unable to save project changes",
+			appmodel.ErrorTypeDatabase,
+		)
+	}
+
+	return &appmodel.RotateProjectSigningSecretResponse{SigningSecret: signingSecret}, nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRotateProjectSigningSecretUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
+	useCase := NewRotateProjectSigningSecretUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectRepositoryMock)
+
+	req := &model.RotateProjectSigningSecretRequest{
+		ActorID:   "fake-actor-id",
+		ProjectID: "fake-project-id",
+	}
+
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [project_not_found]: project not found")
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	projectRepositoryMock.
+		EXPECT().
+		FindById(req.ProjectID).
+		AnyTimes().
+		Return(project, nil)
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(&domainmodel.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    req.ActorID,
+			Role:      domainmodel.ProjectRoleEditor,
+		}, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing_project_permission", err.(*model.AppError).Code)
+
+	req.ActorID = project.OwnerID
+	projectMemberRepositoryMock.
+		EXPECT().
+		FindByProjectAndUser(project.ID, project.OwnerID).
+		AnyTimes().
+		Return(project.Memberships[0], nil)
+	projectRepositoryMock.
+		EXPECT().
+		Save(project).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_save_project_changes]: unable to save project changes")
+
+	projectRepositoryMock.
+		EXPECT().
+		Save(project).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, *project.SigningSecret, res.SigningSecret)
+}
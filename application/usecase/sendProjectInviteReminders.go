@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// SendProjectInviteRemindersUseCase runs on a schedule, expiring overdue invites and reminding the ones about to expire
+type SendProjectInviteRemindersUseCase struct {
+	projectInviteRepository repository.ProjectInviteRepository
+	producerFactory         kafka.ProducerFactory
+}
+
+func NewSendProjectInviteRemindersUseCase(
+	projectInviteRepository repository.ProjectInviteRepository,
+	producerFactory kafka.ProducerFactory,
+) *SendProjectInviteRemindersUseCase {
+	return &SendProjectInviteRemindersUseCase{projectInviteRepository, producerFactory}
+}
+
+func (useCase *SendProjectInviteRemindersUseCase) Execute(
+	req *appmodel.SendProjectInviteRemindersRequest,
+) (*appmodel.SendProjectInviteRemindersResponse, error) {
+	expiredCount, err := useCase.projectInviteRepository.ExpireOverdue(req.Now)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_expire_project_invites", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	invites, err := useCase.projectInviteRepository.ListPendingToRemind(
+		req.Now,
+		req.Now.Add(model.ProjectInviteReminderLeadTime),
+	)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_list_project_invites", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	response := &appmodel.SendProjectInviteRemindersResponse{ExpiredCount: expiredCount}
+	for _, invite := range invites {
+		if !invite.NeedsReminder(req.Now) {
+			continue
+		}
+
+		// claiming first keeps concurrent runs from reminding twice, at the cost of a lost reminder if sending fails
+		claimed, err := useCase.projectInviteRepository.ClaimReminder(invite.ID, req.Now)
+		if err != nil {
+			slog.Error("Unable to claim project invite reminder", "invite", invite.ID, "error", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		err = produceProjectInviteEmail(
+			useCase.producerFactory,
+			invite,
+			"Trackr | Your project invite is about to expire",
+			"Your project invite is about to expire",
+			[]string{
+				fmt.Sprintf("You have been invited to join the project %s and haven't answered yet.", invite.Project.Name),
+			},
+		)
+		if err != nil {
+			slog.Error("Unable to queue project invite reminder email", "invite", invite.ID, "error", err)
+			continue
+		}
+		response.RemindedCount++
+	}
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/kafka"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendProjectInviteRemindersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	producerFactoryMock := kafka.NewMockProducerFactory(ctrl)
+	useCase := NewSendProjectInviteRemindersUseCase(projectInviteRepositoryMock, producerFactoryMock)
+
+	now := time.Now()
+	req := &appmodel.SendProjectInviteRemindersRequest{Now: now}
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		ExpireOverdue(now).
+		Return(int64(0), errors.New("unexpected error"))
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_expire_project_invites", err.(*appmodel.AppError).Code)
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		ExpireOverdue(now).
+		AnyTimes().
+		Return(int64(2), nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ListPendingToRemind(now, now.Add(domainmodel.ProjectInviteReminderLeadTime)).
+		Return(nil, errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_list_project_invites", err.(*appmodel.AppError).Code)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	newInvite := func(email string, expiresIn time.Duration) *domainmodel.ProjectInvite {
+		user, _ := factory.NewVerifiedUser(email, "Jane Doe", "fake-password")
+		invite, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
+		expiresAt := now.Add(expiresIn)
+		invite.ExpiresAt = &expiresAt
+		return invite
+	}
+	expiringInvite := newInvite("expiring@gmail.com", time.Hour)
+	claimedInvite := newInvite("claimed@gmail.com", time.Hour)
+	failingInvite := newInvite("failing@gmail.com", time.Hour)
+	recentInvite := newInvite("recent@gmail.com", domainmodel.ProjectInviteTTL)
+
+	projectInviteRepositoryMock.
+		EXPECT().
+		ListPendingToRemind(now, now.Add(domainmodel.ProjectInviteReminderLeadTime)).
+		Return([]*domainmodel.ProjectInvite{expiringInvite, claimedInvite, failingInvite, recentInvite}, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ClaimReminder(expiringInvite.ID, now).
+		Return(true, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ClaimReminder(claimedInvite.ID, now).
+		Return(false, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ClaimReminder(failingInvite.ID, now).
+		Return(false, errors.New("unexpected error"))
+
+	producerMock := kafka.NewMockProducer(ctrl)
+	producerFactoryMock.
+		EXPECT().
+		NewProducer(gomock.Any()).
+		Return(producerMock, nil)
+	producerMock.
+		EXPECT().
+		Produce("email-sending-requested", gomock.Any()).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), res.ExpiredCount)
+	assert.Equal(t, 1, res.RemindedCount)
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"sort"
+
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+)
+
+// trackEndUserSessions adds the given events, all from the same project, to the sessions they belong to.
+// Events sent with a session ID are grouped by it, the other ones are grouped by end user and split
+// whenever the end user is inactive for longer than the configured session timeout. Sessions are read and saved
+// holding a lock on each session ID and end user, as requests tracking the same sessions run concurrently.
+func trackEndUserSessions(
+	endUserSessionRepository repository.EndUserSessionRepository,
+	project *model.Project,
+	events []*model.Event,
+) error {
+	sortedEvents := make([]*model.Event, 0, len(events))
+	clientSessionIDs := make([]string, 0)
+	endUserIDs := make([]string, 0)
+	for _, event := range events {
+		switch {
+		case event.SessionID != nil:
+			clientSessionIDs = append(clientSessionIDs, *event.SessionID)
+		case event.EndUserID() != "":
+			endUserIDs = append(endUserIDs, event.EndUserID())
+		default:
+			continue
+		}
+		sortedEvents = append(sortedEvents, event)
+	}
+	if len(sortedEvents) == 0 {
+		return nil
+	}
+	sort.SliceStable(sortedEvents, func(i, j int) bool {
+		return sortedEvents[i].Timestamp.Before(*sortedEvents[j].Timestamp)
+	})
+
+	sessionKeys := make([]string, 0, len(sortedEvents))
+	for _, clientSessionID := range clientSessionIDs {
+		sessionKeys = append(sessionKeys, "session_id:"+clientSessionID)
+	}
+	for _, endUserID := range endUserIDs {
+		sessionKeys = append(sessionKeys, "end_user_id:"+endUserID)
+	}
+	return endUserSessionRepository.RunLocked(
+		project.ID,
+		sessionKeys,
+		func(endUserSessionRepository repository.EndUserSessionRepository) error {
+			return trackSortedEndUserSessions(endUserSessionRepository, project, sortedEvents, clientSessionIDs, endUserIDs)
+		},
+	)
+}
+
+func trackSortedEndUserSessions(
+	endUserSessionRepository repository.EndUserSessionRepository,
+	project *model.Project,
+	sortedEvents []*model.Event,
+	clientSessionIDs []string,
+	endUserIDs []string,
+) error {
+	sessionsByClientSessionID := map[string]*model.EndUserSession{}
+	clientSessions, err := endUserSessionRepository.ListByClientSessionIDs(project.ID, clientSessionIDs)
+	if err != nil {
+		return err
+	}
+	for _, session := range clientSessions {
+		sessionsByClientSessionID[*session.ClientSessionID] = session
+	}
+
+	timeout := config.GetAppConfig().EventSessionTimeout
+	sessionsByEndUserID := map[string][]*model.EndUserSession{}
+	endUserSessions, err := endUserSessionRepository.ListByEndUserIDs(
+		project.ID,
+		endUserIDs,
+		sortedEvents[0].Timestamp.Add(-timeout),
+		sortedEvents[len(sortedEvents)-1].Timestamp.Add(timeout),
+	)
+	if err != nil {
+		return err
+	}
+	for _, session := range endUserSessions {
+		sessionsByEndUserID[session.EndUserID] = append(sessionsByEndUserID[session.EndUserID], session)
+	}
+
+	trackedSessions := make([]*model.EndUserSession, 0)
+	isTracked := map[string]bool{}
+	for _, event := range sortedEvents {
+		var session *model.EndUserSession
+		if event.SessionID != nil {
+			session = sessionsByClientSessionID[*event.SessionID]
+		} else {
+			for _, endUserSession := range sessionsByEndUserID[event.EndUserID()] {
+				if endUserSession.Covers(*event.Timestamp, timeout) {
+					session = endUserSession
+					break
+				}
+			}
+		}
+
+		if session == nil {
+			session, err = model.NewEndUserSession(event)
+			if err != nil {
+				return err
+			}
+			if event.SessionID != nil {
+				sessionsByClientSessionID[*event.SessionID] = session
+			} else {
+				sessionsByEndUserID[session.EndUserID] = append(sessionsByEndUserID[session.EndUserID], session)
+			}
+		} else {
+			session.Track(event)
+		}
+
+		if !isTracked[session.ID] {
+			isTracked[session.ID] = true
+			trackedSessions = append(trackedSessions, session)
+		}
+	}
+
+	return endUserSessionRepository.BatchSave(trackedSessions)
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTrackEndUserSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockEndUserSessionRepository := repository.NewMockEndUserSessionRepository(ctrl)
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(name string, minutes int, distinctID string, sessionID string) *model.Event {
+		event, _ := model.NewEvent(name, nil, project)
+		timestamp := start.Add(time.Duration(minutes) * time.Minute)
+		event.Timestamp = &timestamp
+		_ = event.Identify(distinctID, "")
+		if sessionID != "" {
+			_ = event.AssignSessionID(sessionID)
+		}
+		return event
+	}
+
+	err := trackEndUserSessions(mockEndUserSessionRepository, project, []*model.Event{newEvent("anonymous", 0, "", "")})
+	assert.Nil(t, err)
+
+	events := []*model.Event{
+		newEvent("pricing", 20, "user-1", ""),
+		newEvent("home", 0, "user-1", ""),
+		// after more than 30 minutes of inactivity
+		newEvent("home", 60, "user-1", ""),
+		newEvent("checkout", 5, "user-2", "fake-session-id"),
+		newEvent("purchase", 120, "user-2", "fake-session-id"),
+		newEvent("home", 25, "user-3", ""),
+	}
+	// sessions are only read and saved holding the locks of their session IDs and end users
+	mockEndUserSessionRepository.
+		EXPECT().
+		RunLocked(project.ID, gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(projectID string, sessionKeys []string, track func(repository.EndUserSessionRepository) error) error {
+			assert.ElementsMatch(t, []string{
+				"session_id:fake-session-id",
+				"session_id:fake-session-id",
+				"end_user_id:user-1",
+				"end_user_id:user-1",
+				"end_user_id:user-1",
+				"end_user_id:user-3",
+			}, sessionKeys)
+			return track(mockEndUserSessionRepository)
+		})
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByClientSessionIDs(project.ID, []string{"fake-session-id", "fake-session-id"}).
+		Return(nil, errors.New("unexpected error"))
+
+	err = trackEndUserSessions(mockEndUserSessionRepository, project, events)
+	assert.NotNil(t, err)
+
+	existingSession := &model.EndUserSession{
+		ID:              "fake-session",
+		ProjectID:       project.ID,
+		EndUserID:       "user-2",
+		ClientSessionID: events[3].SessionID,
+		StartedAt:       start,
+		EndedAt:         start,
+		EventCount:      1,
+		EntryEvent:      "home",
+		ExitEvent:       "home",
+	}
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByClientSessionIDs(project.ID, []string{"fake-session-id", "fake-session-id"}).
+		Return([]*model.EndUserSession{existingSession}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByEndUserIDs(
+			project.ID,
+			[]string{"user-1", "user-1", "user-1", "user-3"},
+			start.Add(-30*time.Minute),
+			start.Add(150*time.Minute),
+		).
+		Return([]*model.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		BatchSave(gomock.Len(4)).
+		DoAndReturn(func(sessions []*model.EndUserSession) error {
+			assert.Equal(t, "user-1", sessions[0].EndUserID)
+			assert.Equal(t, 2, sessions[0].EventCount)
+			assert.Equal(t, "home", sessions[0].EntryEvent)
+			assert.Equal(t, "pricing", sessions[0].ExitEvent)
+			assert.Equal(t, (20 * time.Minute).Seconds(), sessions[0].DurationSeconds)
+
+			assert.Same(t, existingSession, sessions[1])
+			assert.Equal(t, 3, existingSession.EventCount)
+			assert.Equal(t, "purchase", existingSession.ExitEvent)
+			assert.Equal(t, (120 * time.Minute).Seconds(), existingSession.DurationSeconds)
+
+			assert.Equal(t, "user-3", sessions[2].EndUserID)
+			assert.Equal(t, "user-1", sessions[3].EndUserID)
+			assert.Equal(t, 1, sessions[3].EventCount)
+			return nil
+		})
+
+	err = trackEndUserSessions(mockEndUserSessionRepository, project, events)
+	assert.Nil(t, err)
+
+	// a failure to lock the sessions is returned as is
+	lockErr := errors.New("unable to lock")
+	mockEndUserSessionRepository.
+		EXPECT().
+		RunLocked(project.ID, gomock.Any(), gomock.Any()).
+		Return(lockErr)
+
+	err = trackEndUserSessions(mockEndUserSessionRepository, project, events)
+	assert.Same(t, lockErr, err)
+}
@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"errors"
+	"time"
+
 	"github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"gorm.io/gorm"
 )
 
@@ -30,17 +33,13 @@ func (useCase *ShowInvitationByProjectAndTokenUseCase) Execute(
 		return nil, model.NewAppError("unable_to_find_invitation", err.Error(), model.ErrorTypeDatabase)
 	}
 
-	return &model.ShowInvitationByProjectAndTokenUseCaseResponse{
-		ID: invitation.ID,
-		Project: &model.InviteProject{
-			ID:   invitation.Project.ID,
-			Name: invitation.Project.Name,
-		},
-		User: &model.InviteUser{
-			ID:    invitation.User.ID,
-			Email: *invitation.User.Email,
-			Name:  invitation.User.Name,
-		},
-		Status: invitation.Status,
-	}, nil
+	// the expiry job may not have run yet, but the invite can't be answered anymore
+	status := invitation.Status
+	if status == domainmodel.ProjectInviteStatusPending && invitation.IsExpired(time.Now()) {
+		status = domainmodel.ProjectInviteStatusExpired
+	}
+
+	response := model.ShowInvitationByProjectAndTokenUseCaseResponse(*newProjectInviteResponse(invitation))
+	response.Status = status
+	return &response, nil
 }
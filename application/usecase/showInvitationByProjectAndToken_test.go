@@ -10,6 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 func TestShowInvitationByProjectAndTokenUseCase_Execute(t *testing.T) {
@@ -44,7 +45,7 @@ func TestShowInvitationByProjectAndTokenUseCase_Execute(t *testing.T) {
 
 	project, _ := factory.NewProjectWithDefaultOwner("fake-project-name")
 	user, _ := factory.NewVerifiedUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
-	invitation, _ := domainmodel.NewProjectInvite(project, user)
+	invitation, _ := domainmodel.NewProjectInvite(project, user, domainmodel.ProjectRoleEditor, project.Memberships[0].User)
 	projectInviteMockRepository.
 		EXPECT().
 		FindByProjectAndToken(req.ProjectID, req.Token).
@@ -60,4 +61,16 @@ func TestShowInvitationByProjectAndTokenUseCase_Execute(t *testing.T) {
 	assert.Equal(t, res.User.Email, *invitation.User.Email)
 	assert.Equal(t, res.User.Name, invitation.User.Name)
 	assert.Equal(t, res.Status, invitation.Status)
+	assert.Equal(t, res.ExpiresAt, invitation.ExpiresAt)
+
+	expiredAt := time.Now().Add(-time.Minute)
+	invitation.ExpiresAt = &expiredAt
+	projectInviteMockRepository.
+		EXPECT().
+		FindByProjectAndToken(req.ProjectID, req.Token).
+		Return(invitation, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Equal(t, domainmodel.ProjectInviteStatusExpired, res.Status)
 }
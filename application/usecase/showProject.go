@@ -7,17 +7,17 @@ import (
 )
 
 type ShowProjectUseCase struct {
-	projectRepository repository.ProjectRepository
-	userRepository    repository.UserRepository
+	projectPermissionService *ProjectPermissionService
+	projectRepository        repository.ProjectRepository
 }
 
 func NewShowProjectUseCase(
+	projectPermissionService *ProjectPermissionService,
 	projectRepository repository.ProjectRepository,
-	userRepository repository.UserRepository,
 ) *ShowProjectUseCase {
 	return &ShowProjectUseCase{
+		projectPermissionService,
 		projectRepository,
-		userRepository,
 	}
 }
 
@@ -30,39 +30,33 @@ func (useCase *ShowProjectUseCase) Execute(req *appmodel.ShowProjectRequest) (*a
 		return nil, appmodel.NewAppError("unable_to_find_project", err.Error(), appmodel.ErrorTypeDatabase)
 	}
 
-	actor, err := useCase.userRepository.FindById(req.ActorID)
-	if err != nil {
-		return nil, appmodel.NewAppError(
-			"unable_to_identify_user",
-			"unable to identify the user trying to see project details",
-			appmodel.ErrorTypeDatabase,
-		)
-	}
-
-	isMember := project.HasMember(actor)
-	if !isMember {
-		return nil, appmodel.NewAppError(
-			"not_project_member",
-			"only project members can see project details",
-			appmodel.ErrorTypeValidation,
-		)
+	actorMembership, appErr := useCase.projectPermissionService.Authorize(
+		project.ID,
+		req.ActorID,
+		ProjectPermissionViewProject,
+	)
+	if appErr != nil {
+		return nil, appErr
 	}
 
 	var members []*appmodel.ProjectMember
-	for _, m := range project.Members {
-		member := &appmodel.ProjectMember{
-			ID:    m.ID,
-			Email: *m.Email,
-			Name:  m.Name,
-		}
-		members = append(members, member)
+	for _, membership := range project.Memberships {
+		members = append(members, &appmodel.ProjectMember{
+			ID:    membership.User.ID,
+			Email: *membership.User.Email,
+			Name:  membership.User.Name,
+			Role:  membership.Role,
+		})
 	}
 
 	return &appmodel.ShowProjectResponse{
-		ID:      project.ID,
-		Name:    project.Name,
-		OwnerID: project.OwnerID,
-		IsOwner: project.OwnerID == req.ActorID,
-		Members: members,
+		ID:                     project.ID,
+		Name:                   project.Name,
+		OwnerID:                project.OwnerID,
+		IsOwner:                project.OwnerID == req.ActorID,
+		Role:                   actorMembership.Role,
+		RequireSignedIngestion: project.RequireSignedIngestion,
+		AllowedOrigins:         project.AllowedOrigins,
+		Members:                members,
 	}, nil
 }
@@ -14,9 +14,9 @@ import (
 
 func TestShowProjectUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	projectMemberRepositoryMock := repository.NewMockProjectMemberRepository(ctrl)
 	projectRepositoryMock := repository.NewMockProjectRepository(ctrl)
-	useCase := NewShowProjectUseCase(projectRepositoryMock, userRepositoryMock)
+	useCase := NewShowProjectUseCase(NewProjectPermissionService(projectMemberRepositoryMock), projectRepositoryMock)
 
 	req := &model.ShowProjectRequest{
 		ActorID:   "fake-actor-id",
@@ -48,43 +48,42 @@ func TestShowProjectUseCase_Execute(t *testing.T) {
 		EXPECT().
 		FindById(req.ProjectID).
 		Return(project, nil)
-	userRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		FindById(req.ActorID).
+		FindByProjectAndUser(project.ID, req.ActorID).
 		Return(nil, errors.New("unexpected error"))
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [unable_to_identify_user] unable to identify the user trying to see project details")
+	assert.Error(t, err, "(database) [unable_to_check_membership] unexpected error")
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake-project")
-	actor, _ := domainmodel.NewUser("jane.doe@gmail.com", "Jane Doe", "fake-password")
 	projectRepositoryMock.
 		EXPECT().
 		FindById(req.ProjectID).
 		Return(project, nil)
-	userRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		FindById(req.ActorID).
-		Return(actor, nil)
+		FindByProjectAndUser(project.ID, req.ActorID).
+		Return(nil, gorm.ErrRecordNotFound)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, res)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "(validation) [not_project_member] only project members can see project details")
+	assert.Error(t, err, "(validation) [not_project_member] only project members can access the project")
 
 	project, _ = factory.NewProjectWithDefaultOwner("fake-project")
 	req.ActorID = project.OwnerID
-	owner := project.Members[0]
+	owner := project.Memberships[0].User
 	projectRepositoryMock.
 		EXPECT().
 		FindById(req.ProjectID).
 		Return(project, nil)
-	userRepositoryMock.
+	projectMemberRepositoryMock.
 		EXPECT().
-		FindById(req.ActorID).
-		Return(owner, nil)
+		FindByProjectAndUser(project.ID, owner.ID).
+		Return(project.Memberships[0], nil)
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, err)
@@ -93,8 +92,10 @@ func TestShowProjectUseCase_Execute(t *testing.T) {
 	assert.Equal(t, res.Name, project.Name)
 	assert.Equal(t, res.OwnerID, project.OwnerID)
 	assert.True(t, res.IsOwner)
+	assert.Equal(t, domainmodel.ProjectRoleOwner, res.Role)
 	assert.Len(t, res.Members, 1)
 	assert.Equal(t, res.Members[0].ID, owner.ID)
 	assert.Equal(t, res.Members[0].Email, *owner.Email)
 	assert.Equal(t, res.Members[0].Name, owner.Name)
+	assert.Equal(t, domainmodel.ProjectRoleOwner, res.Members[0].Role)
 }
@@ -4,16 +4,24 @@ import (
 	"github.com/RuanScherer/journey-track-api/application/jwt"
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type SignInUseCase struct {
-	userRepository repository.UserRepository
-	jwtManager     jwt.Manager
+	userRepository             repository.UserRepository
+	userSessionRepository      repository.UserSessionRepository
+	userRefreshTokenRepository repository.UserRefreshTokenRepository
+	jwtManager                 jwt.Manager
 }
 
-func NewSignInUseCase(userRepository repository.UserRepository, jwtManager jwt.Manager) *SignInUseCase {
-	return &SignInUseCase{userRepository, jwtManager}
+func NewSignInUseCase(
+	userRepository repository.UserRepository,
+	userSessionRepository repository.UserSessionRepository,
+	userRefreshTokenRepository repository.UserRefreshTokenRepository,
+	jwtManager jwt.Manager,
+) *SignInUseCase {
+	return &SignInUseCase{userRepository, userSessionRepository, userRefreshTokenRepository, jwtManager}
 }
 
 func (useCase *SignInUseCase) Execute(req *appmodel.SignInRequest) (*appmodel.SignInResponse, *appmodel.AppError) {
@@ -39,13 +47,34 @@ func (useCase *SignInUseCase) Execute(req *appmodel.SignInRequest) (*appmodel.Si
 		)
 	}
 
-	token, err := useCase.jwtManager.CreateJwtFromUser(user)
+	session, err := model.NewUserSession(user, req.UserAgent, req.IpAddress)
+	if err != nil {
+		return nil, appmodel.NewAppError("unexpected_error", err.Error(), appmodel.ErrorTypeServer)
+	}
+
+	err = useCase.userSessionRepository.Create(session)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	refreshToken, plainRefreshToken, appErr := newUserRefreshToken(useCase.jwtManager, session)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	err = useCase.userRefreshTokenRepository.Create(refreshToken)
+	if err != nil {
+		return nil, appmodel.NewAppError("unable_to_save_refresh_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	token, err := useCase.jwtManager.CreateJwtFromUser(user, session)
 	if err != nil {
 		return nil, appmodel.NewAppError("unexpected_error", err.Error(), appmodel.ErrorTypeServer)
 	}
 
 	return &appmodel.SignInResponse{
-		AccessToken: token,
+		AccessToken:  token,
+		RefreshToken: plainRefreshToken,
 		User: appmodel.SignInUser{
 			ID:    user.ID,
 			Email: *user.Email,
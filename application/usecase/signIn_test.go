@@ -15,14 +15,18 @@ import (
 func TestSignInUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	userRefreshTokenRepositoryMock := repository.NewMockUserRefreshTokenRepository(ctrl)
 	jwtManagerMock := jwt.NewMockManager(ctrl)
-	useCase := NewSignInUseCase(userRepositoryMock, jwtManagerMock)
+	useCase := NewSignInUseCase(userRepositoryMock, userSessionRepositoryMock, userRefreshTokenRepositoryMock, jwtManagerMock)
 
 	email := "john.doe@gmail.com"
 	password := "123456"
 	req := &model.SignInRequest{
-		Email:    email,
-		Password: password,
+		Email:     email,
+		Password:  password,
+		UserAgent: "fake-user-agent",
+		IpAddress: "127.0.0.1",
 	}
 
 	userRepositoryMock.
@@ -61,9 +65,52 @@ func TestSignInUseCase_Execute(t *testing.T) {
 	assert.Error(t, err, "(validation) [invalid_auth_credentials]: Invalid authentication credentials")
 
 	req.Password = "a@bh8i32#1"
+	userSessionRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_session", err.Code)
+
+	var session *domainmodel.UserSession
+	userSessionRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(createdSession *domainmodel.UserSession) error {
+			session = createdSession
+			return nil
+		})
+	jwtManagerMock.
+		EXPECT().
+		CreateRefreshToken().
+		AnyTimes().
+		Return("fake-refresh-token", "fake-refresh-token-hash", nil)
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_save_refresh_token", err.Code)
+
+	var refreshToken *domainmodel.UserRefreshToken
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		Create(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(createdRefreshToken *domainmodel.UserRefreshToken) error {
+			refreshToken = createdRefreshToken
+			return nil
+		})
 	jwtManagerMock.
 		EXPECT().
-		CreateJwtFromUser(user).
+		CreateJwtFromUser(user, gomock.Any()).
 		Return("", errors.New("unexpected error"))
 
 	res, err = useCase.Execute(req)
@@ -73,8 +120,11 @@ func TestSignInUseCase_Execute(t *testing.T) {
 
 	jwtManagerMock.
 		EXPECT().
-		CreateJwtFromUser(user).
-		Return("fake-token", nil)
+		CreateJwtFromUser(user, gomock.Any()).
+		DoAndReturn(func(_ *domainmodel.User, tokenSession *domainmodel.UserSession) (string, error) {
+			assert.Equal(t, session, tokenSession)
+			return "fake-token", nil
+		})
 
 	res, err = useCase.Execute(req)
 	assert.Nil(t, err)
@@ -83,4 +133,10 @@ func TestSignInUseCase_Execute(t *testing.T) {
 	assert.Equal(t, res.User.Email, *user.Email)
 	assert.Equal(t, res.User.Name, user.Name)
 	assert.Equal(t, res.AccessToken, "fake-token")
+	assert.Equal(t, "fake-refresh-token", res.RefreshToken)
+	assert.Equal(t, session.ID, refreshToken.SessionID)
+	assert.Equal(t, "fake-refresh-token-hash", refreshToken.TokenHash)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, "fake-user-agent", session.UserAgent)
+	assert.Equal(t, "127.0.0.1", session.IpAddress)
 }
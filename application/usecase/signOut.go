@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"gorm.io/gorm"
+)
+
+// SignOutUseCase revokes the session of the access token, signing out is a no-op for tokens already unusable
+type SignOutUseCase struct {
+	jwtManager                 jwt.Manager
+	userSessionRepository      repository.UserSessionRepository
+	userRefreshTokenRepository repository.UserRefreshTokenRepository
+}
+
+func NewSignOutUseCase(
+	jwtManager jwt.Manager,
+	userSessionRepository repository.UserSessionRepository,
+	userRefreshTokenRepository repository.UserRefreshTokenRepository,
+) *SignOutUseCase {
+	return &SignOutUseCase{jwtManager, userSessionRepository, userRefreshTokenRepository}
+}
+
+func (useCase *SignOutUseCase) Execute(req *appmodel.SignOutRequest) error {
+	sessionID, err := useCase.findSessionID(req)
+	if err != nil || sessionID == "" {
+		return err
+	}
+
+	session, err := useCase.userSessionRepository.FindById(sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return appmodel.NewAppError("unable_to_find_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) {
+		return nil
+	}
+
+	_ = session.Revoke(now)
+	err = useCase.userSessionRepository.Save(session)
+	if err != nil {
+		return appmodel.NewAppError("unable_to_save_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return nil
+}
+
+// findSessionID reads the session from the access token, falling back to the refresh token once it expired
+func (useCase *SignOutUseCase) findSessionID(req *appmodel.SignOutRequest) (string, error) {
+	claims, err := useCase.jwtManager.GetJwtClaims(req.AccessToken)
+	if err == nil && claims.ID != "" {
+		return claims.ID, nil
+	}
+
+	if req.RefreshToken == "" {
+		return "", nil
+	}
+
+	refreshToken, err := useCase.userRefreshTokenRepository.FindByTokenHash(
+		useCase.jwtManager.HashRefreshToken(req.RefreshToken),
+	)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", appmodel.NewAppError("unable_to_find_refresh_token", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	return refreshToken.SessionID, nil
+}
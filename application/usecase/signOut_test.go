@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	"github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestSignOutUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jwtManagerMock := jwt.NewMockManager(ctrl)
+	userSessionRepositoryMock := repository.NewMockUserSessionRepository(ctrl)
+	userRefreshTokenRepositoryMock := repository.NewMockUserRefreshTokenRepository(ctrl)
+	useCase := NewSignOutUseCase(jwtManagerMock, userSessionRepositoryMock, userRefreshTokenRepositoryMock)
+
+	req := &model.SignOutRequest{AccessToken: "fake-token"}
+
+	jwtManagerMock.
+		EXPECT().
+		GetJwtClaims(req.AccessToken).
+		Times(3).
+		Return(nil, model.NewAppError("expired_access_token", "expired access token", model.ErrorTypeAuthentication))
+
+	err := useCase.Execute(req)
+	assert.Nil(t, err)
+
+	req.RefreshToken = "fake-refresh-token"
+	jwtManagerMock.
+		EXPECT().
+		HashRefreshToken(req.RefreshToken).
+		AnyTimes().
+		Return("fake-refresh-token-hash")
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash("fake-refresh-token-hash").
+		Return(nil, gorm.ErrRecordNotFound)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+
+	// the expired access token still signs out through the refresh token
+	expiredSession := &domainmodel.UserSession{ID: "fake-expired-session-id", ExpiresAt: time.Now().Add(time.Hour)}
+	userRefreshTokenRepositoryMock.
+		EXPECT().
+		FindByTokenHash("fake-refresh-token-hash").
+		Return(&domainmodel.UserRefreshToken{SessionID: expiredSession.ID}, nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById(expiredSession.ID).
+		Return(expiredSession, nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(expiredSession).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, expiredSession.RevokedAt)
+
+	jwtManagerMock.
+		EXPECT().
+		GetJwtClaims(req.AccessToken).
+		AnyTimes().
+		Return(&model.JwtClaims{RegisteredClaims: jwtlib.RegisteredClaims{ID: "fake-session-id"}}, nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById("fake-session-id").
+		Return(nil, errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable_to_find_session", err.(*model.AppError).Code)
+
+	session := &domainmodel.UserSession{ID: "fake-session-id", ExpiresAt: time.Now().Add(time.Hour)}
+	userSessionRepositoryMock.
+		EXPECT().
+		FindById("fake-session-id").
+		AnyTimes().
+		Return(session, nil)
+	userSessionRepositoryMock.
+		EXPECT().
+		Save(session).
+		Return(nil)
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, session.RevokedAt)
+
+	// signing out again doesn't touch the revoked session
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+)
+
+const (
+	TimeIntervalMinute = "minute"
+	TimeIntervalHour   = "hour"
+	TimeIntervalDay    = "day"
+	TimeIntervalWeek   = "week"
+	TimeIntervalMonth  = "month"
+)
+
+// truncateToInterval returns the start of the interval containing t, in the location of t.
+// Weeks start on monday to match postgres date_trunc.
+func truncateToInterval(t time.Time, interval string) time.Time {
+	year, month, day := t.Date()
+	location := t.Location()
+	switch interval {
+	case TimeIntervalMinute:
+		return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, location)
+	case TimeIntervalHour:
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, location)
+	case TimeIntervalWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, location)
+	case TimeIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, location)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, location)
+	}
+}
+
+func addIntervals(t time.Time, interval string, count int) time.Time {
+	switch interval {
+	case TimeIntervalMinute:
+		return t.Add(time.Duration(count) * time.Minute)
+	case TimeIntervalHour:
+		return t.Add(time.Duration(count) * time.Hour)
+	case TimeIntervalWeek:
+		return t.AddDate(0, 0, 7*count)
+	case TimeIntervalMonth:
+		return t.AddDate(0, count, 0)
+	default:
+		return t.AddDate(0, 0, count)
+	}
+}
+
+// wallClockIn interprets the wall clock of t, regardless of its location, as a time in the given location
+func wallClockIn(t time.Time, location *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// intervalsBetween returns how many whole intervals separate the interval starts from and to, in the location of from
+func intervalsBetween(from time.Time, to time.Time, interval string) int {
+	to = to.In(from.Location())
+	switch interval {
+	case TimeIntervalMinute:
+		return int(to.Sub(from) / time.Minute)
+	case TimeIntervalHour:
+		return int(to.Sub(from) / time.Hour)
+	case TimeIntervalMonth:
+		return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	}
+
+	// days are compared on the calendar so daylight saving time changes don't shift them
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(toDate.Sub(fromDate).Hours() / 24)
+	if interval == TimeIntervalWeek {
+		return days / 7
+	}
+	return days
+}
+
+func loadTimezone(timezone string) (*time.Location, *appmodel.AppError) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_timezone", "invalid timezone", appmodel.ErrorTypeValidation)
+	}
+	return location, nil
+}
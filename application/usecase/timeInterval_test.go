@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateToInterval(t *testing.T) {
+	location, _ := time.LoadLocation("America/Sao_Paulo")
+	// wednesday
+	moment := time.Date(2024, 3, 13, 15, 42, 31, 100, location)
+
+	assert.Equal(t, time.Date(2024, 3, 13, 15, 42, 0, 0, location), truncateToInterval(moment, TimeIntervalMinute))
+	assert.Equal(t, time.Date(2024, 3, 13, 15, 0, 0, 0, location), truncateToInterval(moment, TimeIntervalHour))
+	assert.Equal(t, time.Date(2024, 3, 13, 0, 0, 0, 0, location), truncateToInterval(moment, TimeIntervalDay))
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, location), truncateToInterval(moment, TimeIntervalWeek))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, location), truncateToInterval(moment, TimeIntervalMonth))
+
+	sunday := time.Date(2024, 3, 17, 10, 0, 0, 0, location)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, location), truncateToInterval(sunday, TimeIntervalWeek))
+}
+
+func TestAddIntervals(t *testing.T) {
+	moment := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 1, 31, 0, 2, 0, 0, time.UTC), addIntervals(moment, TimeIntervalMinute, 2))
+	assert.Equal(t, time.Date(2024, 1, 31, 2, 0, 0, 0, time.UTC), addIntervals(moment, TimeIntervalHour, 2))
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), addIntervals(moment, TimeIntervalDay, 2))
+	assert.Equal(t, time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), addIntervals(moment, TimeIntervalWeek, 2))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), addIntervals(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TimeIntervalMonth, 2))
+}
+
+func TestIntervalsBetween(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, location)
+
+	assert.Equal(t, 90, intervalsBetween(from, from.Add(90*time.Minute), TimeIntervalMinute))
+	assert.Equal(t, 3, intervalsBetween(from, from.Add(3*time.Hour), TimeIntervalHour))
+	// crosses the daylight saving time change of 2024-03-10
+	assert.Equal(t, 14, intervalsBetween(from, time.Date(2024, 3, 18, 0, 0, 0, 0, location), TimeIntervalDay))
+	assert.Equal(t, 2, intervalsBetween(from, time.Date(2024, 3, 18, 0, 0, 0, 0, location), TimeIntervalWeek))
+	assert.Equal(t, 11, intervalsBetween(time.Date(2024, 3, 1, 0, 0, 0, 0, location), time.Date(2025, 2, 1, 0, 0, 0, 0, location), TimeIntervalMonth))
+}
@@ -1,38 +1,144 @@
 package usecase
 
 import (
+	"log/slog"
+	"time"
+
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/config"
 	"github.com/RuanScherer/journey-track-api/domain/model"
 )
 
 type TrackEventUseCase struct {
-	projectRepository repository.ProjectRepository
-	eventRepository   repository.EventRepository
+	projectApiKeyRepository      repository.ProjectApiKeyRepository
+	ingestionSignatureRepository repository.IngestionSignatureRepository
+	eventRepository              repository.EventRepository
+	endUserSessionRepository     repository.EndUserSessionRepository
+	projectUsageRepository       repository.ProjectUsageRepository
 }
 
 func NewTrackEventUseCase(
-	projectRepository repository.ProjectRepository,
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+	ingestionSignatureRepository repository.IngestionSignatureRepository,
 	eventRepository repository.EventRepository,
+	endUserSessionRepository repository.EndUserSessionRepository,
+	projectUsageRepository repository.ProjectUsageRepository,
 ) *TrackEventUseCase {
-	return &TrackEventUseCase{projectRepository, eventRepository}
+	return &TrackEventUseCase{
+		projectApiKeyRepository,
+		ingestionSignatureRepository,
+		eventRepository,
+		endUserSessionRepository,
+		projectUsageRepository,
+	}
 }
 
 func (useCase *TrackEventUseCase) Execute(req *appmodel.TrackEventRequest) error {
-	project, err := useCase.projectRepository.FindByToken(req.ProjectToken)
-	if err != nil {
-		return appmodel.NewAppError("project_not_found", "project not found", appmodel.ErrorTypeDatabase)
+	receivedAt := time.Now()
+	apiKey, appErr := authenticateProjectApiKey(useCase.projectApiKeyRepository, req.ApiKey, model.ProjectApiKeyScopeIngest)
+	if appErr != nil {
+		return appErr
+	}
+
+	appErr = verifyIngestionOrigin(apiKey.Project, req.Origin)
+	if appErr != nil {
+		return appErr
+	}
+
+	appErr = verifyIngestionSignature(useCase.ingestionSignatureRepository, apiKey, req.Signature, receivedAt)
+	if appErr != nil {
+		return appErr
+	}
+	project := apiKey.Project
+
+	event, appErr := newTrackedEvent(req, project, receivedAt)
+	if appErr != nil {
+		return appErr
+	}
+
+	if event.ClientEventID != nil {
+		err := releaseExpiredClientEventIDs(useCase.eventRepository, project, []string{*event.ClientEventID}, receivedAt)
+		if err != nil {
+			return appmodel.NewAppError("unable_to_deduplicate_event", err.Error(), appmodel.ErrorTypeDatabase)
+		}
 	}
 
-	event, err := model.NewEvent(req.Name, project)
+	err := reserveEventQuota(useCase.projectUsageRepository, project, 1, receivedAt)
 	if err != nil {
-		return appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+		return err
 	}
 
-	err = useCase.eventRepository.Register(event)
+	// the client event ID unique index skips retries, even when they're sent concurrently
+	registered, err := useCase.eventRepository.Register(event)
 	if err != nil {
+		releaseEventQuota(useCase.projectUsageRepository, project, 1, receivedAt)
 		return appmodel.NewAppError("unable_to_track_event", err.Error(), appmodel.ErrorTypeDatabase)
 	}
+	if !registered {
+		releaseEventQuota(useCase.projectUsageRepository, project, 1, receivedAt)
+		return nil
+	}
+
+	// the event is already registered, failing the request would only make the client send it again
+	err = trackEndUserSessions(useCase.endUserSessionRepository, project, []*model.Event{event})
+	if err != nil {
+		slog.Error("Unable to track end user session", "error", err)
+	}
 
 	return nil
 }
+
+func newTrackedEvent(
+	req *appmodel.TrackEventRequest,
+	project *model.Project,
+	receivedAt time.Time,
+) (*model.Event, *appmodel.AppError) {
+	event, err := model.NewEvent(req.Name, req.Properties, project)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	if req.EventID != "" {
+		err = event.AssignClientEventID(req.EventID)
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
+	err = event.Identify(req.DistinctID, req.AnonymousID)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	if req.SessionID != "" {
+		err = event.AssignSessionID(req.SessionID)
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
+	if req.Timestamp != nil {
+		appConfig := config.GetAppConfig()
+		err = event.ApplyClientTimestamp(*req.Timestamp, req.SentAt, receivedAt, model.EventTimestampLimits{
+			MaxFutureDrift: appConfig.EventMaxFutureDrift,
+			MaxPastAge:     appConfig.EventMaxPastAge,
+		})
+		if err != nil {
+			return nil, appmodel.NewAppError("invalid_event_timestamp", err.Error(), appmodel.ErrorTypeValidation)
+		}
+	}
+
+	return event, nil
+}
+
+// client event IDs are only used for deduplication inside the configured window, so older events release them
+func releaseExpiredClientEventIDs(
+	eventRepository repository.EventRepository,
+	project *model.Project,
+	clientEventIDs []string,
+	receivedAt time.Time,
+) error {
+	deduplicationWindow := config.GetAppConfig().EventDeduplicationWindow
+	return eventRepository.ReleaseClientEventIDs(project.ID, clientEventIDs, receivedAt.Add(-deduplicationWindow))
+}
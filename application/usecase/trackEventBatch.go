@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/asaskevich/govalidator"
+)
+
+const MaxTrackEventBatchSize = 1000
+
+type TrackEventBatchUseCase struct {
+	projectApiKeyRepository      repository.ProjectApiKeyRepository
+	ingestionSignatureRepository repository.IngestionSignatureRepository
+	eventRepository              repository.EventRepository
+	endUserSessionRepository     repository.EndUserSessionRepository
+	projectUsageRepository       repository.ProjectUsageRepository
+}
+
+func NewTrackEventBatchUseCase(
+	projectApiKeyRepository repository.ProjectApiKeyRepository,
+	ingestionSignatureRepository repository.IngestionSignatureRepository,
+	eventRepository repository.EventRepository,
+	endUserSessionRepository repository.EndUserSessionRepository,
+	projectUsageRepository repository.ProjectUsageRepository,
+) *TrackEventBatchUseCase {
+	return &TrackEventBatchUseCase{
+		projectApiKeyRepository,
+		ingestionSignatureRepository,
+		eventRepository,
+		endUserSessionRepository,
+		projectUsageRepository,
+	}
+}
+
+func (useCase *TrackEventBatchUseCase) Execute(
+	req *appmodel.TrackEventBatchRequest,
+) (*appmodel.TrackEventBatchResponse, error) {
+	receivedAt := time.Now()
+
+	if len(req.Events) == 0 {
+		return nil, appmodel.NewAppError("empty_event_batch", "event batch is empty", appmodel.ErrorTypeValidation)
+	}
+
+	if len(req.Events) > MaxTrackEventBatchSize {
+		return nil, appmodel.NewAppError(
+			"event_batch_too_large",
+			fmt.Sprintf("event batch should have at most %d events", MaxTrackEventBatchSize),
+			appmodel.ErrorTypeValidation,
+		)
+	}
+
+	apiKey, appErr := authenticateProjectApiKey(useCase.projectApiKeyRepository, req.ApiKey, model.ProjectApiKeyScopeIngest)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	appErr = verifyIngestionOrigin(apiKey.Project, req.Origin)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	appErr = verifyIngestionSignature(useCase.ingestionSignatureRepository, apiKey, req.Signature, receivedAt)
+	if appErr != nil {
+		return nil, appErr
+	}
+	project := apiKey.Project
+
+	response := &appmodel.TrackEventBatchResponse{
+		Results: make([]*appmodel.TrackEventBatchResult, 0, len(req.Events)),
+	}
+	events := make([]*model.Event, 0, len(req.Events))
+	for index, eventReq := range req.Events {
+		event, appErr := useCase.newBatchEvent(eventReq, project, receivedAt)
+		if appErr != nil {
+			response.Rejected++
+			response.Results = append(response.Results, &appmodel.TrackEventBatchResult{
+				Index:  index,
+				Status: appmodel.TrackEventBatchResultRejected,
+				Error:  appErr,
+			})
+			continue
+		}
+
+		events = append(events, event)
+		response.Accepted++
+		response.Results = append(response.Results, &appmodel.TrackEventBatchResult{
+			Index:  index,
+			Status: appmodel.TrackEventBatchResultAccepted,
+		})
+	}
+
+	clientEventIDs := make([]string, 0)
+	for _, event := range events {
+		if event.ClientEventID != nil {
+			clientEventIDs = append(clientEventIDs, *event.ClientEventID)
+		}
+	}
+	if len(clientEventIDs) > 0 {
+		err := releaseExpiredClientEventIDs(useCase.eventRepository, project, clientEventIDs, receivedAt)
+		if err != nil {
+			return nil, appmodel.NewAppError("unable_to_deduplicate_events", err.Error(), appmodel.ErrorTypeDatabase)
+		}
+	}
+
+	events = skipRepeatedEvents(events)
+	err := reserveEventQuota(useCase.projectUsageRepository, project, len(events), receivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client event ID unique index skips retries of registered events, even when they're sent concurrently
+	registeredEvents, err := useCase.eventRepository.BatchRegister(events)
+	if err != nil {
+		releaseEventQuota(useCase.projectUsageRepository, project, len(events), receivedAt)
+		return nil, appmodel.NewAppError("unable_to_track_events", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+	releaseEventQuota(useCase.projectUsageRepository, project, len(events)-len(registeredEvents), receivedAt)
+
+	// the events are already registered, failing the request would only make the client send them again
+	err = trackEndUserSessions(useCase.endUserSessionRepository, project, registeredEvents)
+	if err != nil {
+		slog.Error("Unable to track end user sessions", "error", err)
+	}
+
+	return response, nil
+}
+
+// skipRepeatedEvents leaves out events repeated in the same batch, retries of registered events are left out
+// when registering them
+func skipRepeatedEvents(events []*model.Event) []*model.Event {
+	isSeen := map[string]bool{}
+	newEvents := make([]*model.Event, 0, len(events))
+	for _, event := range events {
+		if event.ClientEventID != nil {
+			if isSeen[*event.ClientEventID] {
+				continue
+			}
+			isSeen[*event.ClientEventID] = true
+		}
+		newEvents = append(newEvents, event)
+	}
+	return newEvents
+}
+
+func (useCase *TrackEventBatchUseCase) newBatchEvent(
+	req *appmodel.TrackEventRequest,
+	project *model.Project,
+	receivedAt time.Time,
+) (*model.Event, *appmodel.AppError) {
+	if req == nil {
+		return nil, appmodel.NewAppError("invalid_data_to_track_event", "event is required", appmodel.ErrorTypeValidation)
+	}
+
+	_, err := govalidator.ValidateStruct(req)
+	if err != nil {
+		return nil, appmodel.NewAppError("invalid_data_to_track_event", err.Error(), appmodel.ErrorTypeValidation)
+	}
+
+	return newTrackedEvent(req, project, receivedAt)
+}
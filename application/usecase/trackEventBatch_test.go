@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/factory"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestTrackEventBatchUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProjectApiKeyRepository := repository.NewMockProjectApiKeyRepository(ctrl)
+	mockEventRepository := repository.NewMockEventRepository(ctrl)
+	mockEndUserSessionRepository := repository.NewMockEndUserSessionRepository(ctrl)
+	mockIngestionSignatureRepository := repository.NewMockIngestionSignatureRepository(ctrl)
+	mockProjectUsageRepository := repository.NewMockProjectUsageRepository(ctrl)
+	useCase := NewTrackEventBatchUseCase(
+		mockProjectApiKeyRepository,
+		mockIngestionSignatureRepository,
+		mockEventRepository,
+		mockEndUserSessionRepository,
+		mockProjectUsageRepository,
+	)
+	mockEndUserSessionRepository.
+		EXPECT().
+		RunLocked(gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(projectID string, sessionKeys []string, track func(repository.EndUserSessionRepository) error) error {
+			return track(mockEndUserSessionRepository)
+		})
+	mockProjectUsageRepository.
+		EXPECT().
+		IncrementEventCount(gomock.Any(), model.UsagePeriodStart(time.Now()), gomock.Any()).
+		AnyTimes().
+		Return(nil)
+
+	req := &appmodel.TrackEventBatchRequest{ApiKey: "fake-api-key"}
+
+	res, err := useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [empty_event_batch]: event batch is empty")
+
+	for i := 0; i <= MaxTrackEventBatchSize; i++ {
+		req.Events = append(req.Events, &appmodel.TrackEventRequest{ApiKey: req.ApiKey, Name: "fake event"})
+	}
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [event_batch_too_large]: event batch should have at most 1000 events")
+
+	req.Events = []*appmodel.TrackEventRequest{
+		{ApiKey: req.ApiKey, Name: "fake event", DistinctID: "user-1"},
+		{ApiKey: req.ApiKey, Name: ""},
+		{ApiKey: req.ApiKey, Name: "other event", Properties: map[string]any{"invalid key": 1}},
+		nil,
+		{ApiKey: req.ApiKey, Name: "other event", Properties: map[string]any{"plan": "pro"}, EventID: "fake-client-event-id"},
+	}
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(model.HashProjectApiKey(req.ApiKey)).
+		Return(nil, gorm.ErrRecordNotFound)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
+
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(model.ProjectApiKeyScopeIngest)
+	apiKey.MarkUsed(time.Now())
+	project := apiKey.Project
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(model.HashProjectApiKey(req.ApiKey)).
+		AnyTimes().
+		Return(apiKey, nil)
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{"fake-client-event-id"}, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_deduplicate_events]: unexpected error")
+
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{"fake-client-event-id"}, gomock.Any()).
+		AnyTimes().
+		Return(nil)
+	mockEventRepository.
+		EXPECT().
+		BatchRegister(gomock.Len(2)).
+		Return(nil, errors.New("unexpected error"))
+	mockProjectUsageRepository.
+		EXPECT().
+		ReleaseEventCount(project.ID, model.UsagePeriodStart(time.Now()), 2).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_track_events]: unexpected error")
+
+	mockEventRepository.
+		EXPECT().
+		BatchRegister(gomock.Len(2)).
+		DoAndReturn(func(events []*model.Event) ([]*model.Event, error) {
+			assert.Equal(t, "fake event", events[0].Name)
+			assert.Equal(t, "other event", events[1].Name)
+			assert.Equal(t, "pro", events[1].Properties["plan"])
+			assert.Equal(t, "fake-client-event-id", *events[1].ClientEventID)
+			return events, nil
+		})
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByClientSessionIDs(project.ID, []string{}).
+		Times(2).
+		Return([]*model.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByEndUserIDs(project.ID, []string{"user-1"}, gomock.Any(), gomock.Any()).
+		Times(2).
+		Return([]*model.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		BatchSave(gomock.Len(1)).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 2, res.Accepted)
+	assert.Equal(t, 3, res.Rejected)
+	assert.Len(t, res.Results, 5)
+	assert.Equal(t, appmodel.TrackEventBatchResultAccepted, res.Results[0].Status)
+	assert.Nil(t, res.Results[0].Error)
+	assert.Equal(t, appmodel.TrackEventBatchResultRejected, res.Results[1].Status)
+	assert.Equal(t, "invalid_data_to_track_event", res.Results[1].Error.Code)
+	assert.Equal(t, appmodel.TrackEventBatchResultRejected, res.Results[2].Status)
+	assert.Equal(t, appmodel.TrackEventBatchResultRejected, res.Results[3].Status)
+	assert.Equal(t, 3, res.Results[3].Index)
+	assert.Equal(t, appmodel.TrackEventBatchResultAccepted, res.Results[4].Status)
+
+	// sessions are tracked on a best effort basis and don't reject the batch
+	mockEventRepository.
+		EXPECT().
+		BatchRegister(gomock.Len(2)).
+		DoAndReturn(func(events []*model.Event) ([]*model.Event, error) {
+			return events, nil
+		})
+	mockEndUserSessionRepository.
+		EXPECT().
+		BatchSave(gomock.Len(1)).
+		Return(errors.New("unexpected error"))
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, res.Accepted)
+
+	req.Events = append(req.Events, &appmodel.TrackEventRequest{
+		ApiKey:  req.ApiKey,
+		Name:    "other event",
+		EventID: "fake-client-event-id",
+	})
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{"fake-client-event-id", "fake-client-event-id"}, gomock.Any()).
+		Return(nil)
+	// the repeated event is only registered once, and the other one is a retry of a registered event
+	mockEventRepository.
+		EXPECT().
+		BatchRegister(gomock.Len(2)).
+		DoAndReturn(func(events []*model.Event) ([]*model.Event, error) {
+			assert.Equal(t, "fake event", events[0].Name)
+			return events[:1], nil
+		})
+	mockProjectUsageRepository.
+		EXPECT().
+		ReleaseEventCount(project.ID, model.UsagePeriodStart(time.Now()), 1).
+		Return(nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByClientSessionIDs(project.ID, []string{}).
+		Return([]*model.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByEndUserIDs(project.ID, []string{"user-1"}, gomock.Any(), gomock.Any()).
+		Return([]*model.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		BatchSave(gomock.Len(1)).
+		Return(nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, res.Accepted)
+	assert.Equal(t, appmodel.TrackEventBatchResultAccepted, res.Results[5].Status)
+
+	monthlyEventQuota := 100
+	project.MonthlyEventQuota = &monthlyEventQuota
+	req.Events = req.Events[:2]
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(nil)
+	mockProjectUsageRepository.
+		EXPECT().
+		ReserveEventCount(project.ID, model.UsagePeriodStart(time.Now()), 1, monthlyEventQuota).
+		Return(false, nil)
+
+	res, err = useCase.Execute(req)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(too_many_requests) [event_quota_exceeded]: project monthly quota of 100 events exceeded")
+}
@@ -5,61 +5,248 @@ import (
 	"github.com/RuanScherer/journey-track-api/application/factory"
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	domainmodel "github.com/RuanScherer/journey-track-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 func TestTrackEventUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	mockProjectRepository := repository.NewMockProjectRepository(ctrl)
+	mockProjectApiKeyRepository := repository.NewMockProjectApiKeyRepository(ctrl)
 	mockEventRepository := repository.NewMockEventRepository(ctrl)
-	useCase := NewTrackEventUseCase(mockProjectRepository, mockEventRepository)
+	mockEndUserSessionRepository := repository.NewMockEndUserSessionRepository(ctrl)
+	mockIngestionSignatureRepository := repository.NewMockIngestionSignatureRepository(ctrl)
+	mockProjectUsageRepository := repository.NewMockProjectUsageRepository(ctrl)
+	useCase := NewTrackEventUseCase(
+		mockProjectApiKeyRepository,
+		mockIngestionSignatureRepository,
+		mockEventRepository,
+		mockEndUserSessionRepository,
+		mockProjectUsageRepository,
+	)
+	mockEndUserSessionRepository.
+		EXPECT().
+		RunLocked(gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(projectID string, sessionKeys []string, track func(repository.EndUserSessionRepository) error) error {
+			return track(mockEndUserSessionRepository)
+		})
+	mockProjectUsageRepository.
+		EXPECT().
+		IncrementEventCount(gomock.Any(), domainmodel.UsagePeriodStart(time.Now()), gomock.Any()).
+		AnyTimes().
+		Return(nil)
 
-	req := &appmodel.TrackEventRequest{Name: "fake event", ProjectToken: "fake-project-token"}
+	req := &appmodel.TrackEventRequest{Name: "fake event", ApiKey: "fake-api-key"}
 
-	mockProjectRepository.
+	mockProjectApiKeyRepository.
 		EXPECT().
-		FindByToken(req.ProjectToken).
-		Return(nil, errors.New("unexpected error"))
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(nil, gorm.ErrRecordNotFound)
 
 	err := useCase.Execute(req)
 	assert.NotNil(t, err)
-	assert.Error(t, err, "project not found")
+	assert.Error(t, err, "(authentication) [invalid_api_key]: invalid api key")
 
 	req.Name = ""
-	project, _ := factory.NewProjectWithDefaultOwner("fake project")
-	mockProjectRepository.
+	apiKey, _, _ := factory.NewProjectApiKeyWithDefaultProject(domainmodel.ProjectApiKeyScopeIngest)
+	apiKey.MarkUsed(time.Now())
+	project := apiKey.Project
+	mockProjectApiKeyRepository.
 		EXPECT().
-		FindByToken(req.ProjectToken).
-		Return(project, nil)
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
 
 	err = useCase.Execute(req)
 	assert.NotNil(t, err)
 
 	req.Name = "fake event"
-	mockProjectRepository.
+	req.Properties = map[string]any{"invalid key": true}
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [invalid_data_to_track_event]: [event] Invalid property key \"invalid key\"")
+
+	req.Properties = map[string]any{"plan": "pro"}
+	futureTimestamp := time.Now().Add(365 * 24 * time.Hour)
+	req.Timestamp = &futureTimestamp
+	mockProjectApiKeyRepository.
 		EXPECT().
-		FindByToken(req.ProjectToken).
-		Return(project, nil)
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(validation) [invalid_event_timestamp]: [event] Timestamp is too far in the future")
+
+	clientTimestamp := time.Now().Add(-time.Hour)
+	req.Timestamp = &clientTimestamp
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
 	mockEventRepository.
 		EXPECT().
 		Register(gomock.Any()).
-		Return(errors.New("unexpected error"))
+		Return(false, errors.New("unexpected error"))
+	mockProjectUsageRepository.
+		EXPECT().
+		ReleaseEventCount(project.ID, domainmodel.UsagePeriodStart(time.Now()), 1).
+		Return(nil)
 
 	err = useCase.Execute(req)
 	assert.NotNil(t, err)
 	assert.Error(t, err, "unexpected error")
 
-	mockProjectRepository.
+	mockProjectApiKeyRepository.
 		EXPECT().
-		FindByToken(req.ProjectToken).
-		Return(project, nil)
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
 	mockEventRepository.
 		EXPECT().
 		Register(gomock.Any()).
+		DoAndReturn(func(event *domainmodel.Event) (bool, error) {
+			assert.Equal(t, clientTimestamp, *event.Timestamp)
+			assert.Equal(t, clientTimestamp, *event.ClientTimestamp)
+			return true, nil
+		})
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+
+	req.EventID = "fake-client-event-id"
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{req.EventID}, gomock.Any()).
+		Return(errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(database) [unable_to_deduplicate_event]: unexpected error")
+
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{req.EventID}, gomock.Any()).
+		DoAndReturn(func(projectID string, clientEventIDs []string, receivedBefore time.Time) error {
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), receivedBefore, time.Minute)
+			return nil
+		})
+	mockEventRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		DoAndReturn(func(event *domainmodel.Event) (bool, error) {
+			assert.Equal(t, req.EventID, *event.ClientEventID)
+			return true, nil
+		})
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+
+	// retries of an already registered event are ignored, giving back the quota they reserved
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+	mockEventRepository.
+		EXPECT().
+		ReleaseClientEventIDs(project.ID, []string{req.EventID}, gomock.Any()).
+		Return(nil)
+	mockEventRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(false, nil)
+	mockProjectUsageRepository.
+		EXPECT().
+		ReleaseEventCount(project.ID, domainmodel.UsagePeriodStart(time.Now()), 1).
 		Return(nil)
 
 	err = useCase.Execute(req)
 	assert.Nil(t, err)
+
+	req.EventID = ""
+	req.SessionID = "fake-session-id"
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+	mockEventRepository.
+		EXPECT().
+		Register(gomock.Any()).
+		Return(true, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByClientSessionIDs(project.ID, []string{req.SessionID}).
+		Return([]*domainmodel.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		ListByEndUserIDs(project.ID, []string{}, gomock.Any(), gomock.Any()).
+		Return([]*domainmodel.EndUserSession{}, nil)
+	mockEndUserSessionRepository.
+		EXPECT().
+		BatchSave(gomock.Len(1)).
+		DoAndReturn(func(sessions []*domainmodel.EndUserSession) error {
+			assert.Equal(t, req.SessionID, *sessions[0].ClientSessionID)
+			return nil
+		})
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+
+	_, _ = project.RotateSigningSecret()
+	_ = project.ChangeSignedIngestionRequirement(true)
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(authentication) [signature_required]: project requires signed ingestion requests")
+
+	req.Origin = "https://example.com"
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	assert.Error(t, err, "(forbidden) [origin_not_allowed]: origin is not allowed to send events to the project")
+
+	req.Origin = ""
+	_ = project.ChangeSignedIngestionRequirement(false)
+	monthlyEventQuota := 100
+	project.MonthlyEventQuota = &monthlyEventQuota
+	mockProjectApiKeyRepository.
+		EXPECT().
+		FindByKeyHash(domainmodel.HashProjectApiKey(req.ApiKey)).
+		Return(apiKey, nil)
+	mockProjectUsageRepository.
+		EXPECT().
+		ReserveEventCount(project.ID, domainmodel.UsagePeriodStart(time.Now()), 1, monthlyEventQuota).
+		Return(false, nil)
+
+	err = useCase.Execute(req)
+	assert.NotNil(t, err)
+	limitErr, ok := err.(*appmodel.LimitExceededError)
+	assert.True(t, ok)
+	assert.Equal(t, "event_quota_exceeded", limitErr.Code)
+	assert.Equal(t, appmodel.LimitKindQuota, limitErr.Kind)
+	assert.Equal(t, monthlyEventQuota, limitErr.Limit)
+	assert.Equal(t, domainmodel.UsagePeriodEnd(time.Now()), limitErr.ResetAt)
 }
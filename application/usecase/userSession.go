@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuanScherer/journey-track-api/application/jwt"
+	appmodel "github.com/RuanScherer/journey-track-api/application/model"
+	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
+	"gorm.io/gorm"
+)
+
+func newUserSessionResponse(session *model.UserSession, currentSessionID string) *appmodel.UserSession {
+	return &appmodel.UserSession{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IpAddress,
+		Current:    session.ID == currentSessionID,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+}
+
+// findUserSession hides sessions of other users as if they didn't exist
+func findUserSession(
+	userSessionRepository repository.UserSessionRepository,
+	userID string,
+	sessionID string,
+) (*model.UserSession, *appmodel.AppError) {
+	session, err := userSessionRepository.FindById(sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appmodel.NewAppError("session_not_found", "session not found", appmodel.ErrorTypeValidation)
+		}
+		return nil, appmodel.NewAppError("unable_to_find_session", err.Error(), appmodel.ErrorTypeDatabase)
+	}
+
+	if session.UserID != userID {
+		return nil, appmodel.NewAppError("session_not_found", "session not found", appmodel.ErrorTypeValidation)
+	}
+	return session, nil
+}
+
+func newUserRefreshToken(
+	jwtManager jwt.Manager,
+	session *model.UserSession,
+) (*model.UserRefreshToken, string, *appmodel.AppError) {
+	plainToken, tokenHash, err := jwtManager.CreateRefreshToken()
+	if err != nil {
+		return nil, "", appmodel.NewAppError("unexpected_error", err.Error(), appmodel.ErrorTypeServer)
+	}
+
+	refreshToken, err := model.NewUserRefreshToken(session, tokenHash)
+	if err != nil {
+		return nil, "", appmodel.NewAppError("unexpected_error", err.Error(), appmodel.ErrorTypeServer)
+	}
+	return refreshToken, plainToken, nil
+}
+
+// revokeUserSessionFamily signs out the session of a reused refresh token, since either copy may be stolen
+func revokeUserSessionFamily(
+	userSessionRepository repository.UserSessionRepository,
+	session *model.UserSession,
+) *appmodel.AppError {
+	err := session.Revoke(time.Now())
+	if err == nil {
+		err = userSessionRepository.Save(session)
+		if err != nil {
+			return appmodel.NewAppError("unable_to_save_session", err.Error(), appmodel.ErrorTypeDatabase)
+		}
+	}
+
+	return appmodel.NewAppError(
+		"refresh_token_reused",
+		"refresh token already used, the session was revoked",
+		appmodel.ErrorTypeAuthentication,
+	)
+}
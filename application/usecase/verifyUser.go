@@ -2,17 +2,24 @@ package usecase
 
 import (
 	"errors"
+	"log/slog"
+
 	appmodel "github.com/RuanScherer/journey-track-api/application/model"
 	"github.com/RuanScherer/journey-track-api/application/repository"
+	"github.com/RuanScherer/journey-track-api/domain/model"
 	"gorm.io/gorm"
 )
 
 type VerifyUserUseCase struct {
-	userRepository repository.UserRepository
+	userRepository          repository.UserRepository
+	projectInviteRepository repository.ProjectInviteRepository
 }
 
-func NewVerifyUserUseCase(userRepository repository.UserRepository) *VerifyUserUseCase {
-	return &VerifyUserUseCase{userRepository}
+func NewVerifyUserUseCase(
+	userRepository repository.UserRepository,
+	projectInviteRepository repository.ProjectInviteRepository,
+) *VerifyUserUseCase {
+	return &VerifyUserUseCase{userRepository, projectInviteRepository}
 }
 
 func (useCase *VerifyUserUseCase) Execute(req *appmodel.VerifyUserRequest) *appmodel.AppError {
@@ -37,5 +44,29 @@ func (useCase *VerifyUserUseCase) Execute(req *appmodel.VerifyUserRequest) *appm
 		return appmodel.NewAppError("unable_to_save_user", "unable to save user", appmodel.ErrorTypeDatabase)
 	}
 
+	useCase.attachProjectInvites(user)
 	return nil
 }
+
+// attachProjectInvites hands the invites sent to the email before registration to the user, so they can be accepted.
+// The user is already verified at this point, so failures are only logged.
+func (useCase *VerifyUserUseCase) attachProjectInvites(user *model.User) {
+	invites, err := useCase.projectInviteRepository.ListPendingUnattachedByEmail(model.NormalizeProjectInviteEmail(*user.Email))
+	if err != nil {
+		slog.Error("Unable to list project invites sent to the user email", "error", err)
+		return
+	}
+
+	for _, invite := range invites {
+		err = invite.AttachUser(user)
+		if err != nil {
+			slog.Error("Unable to attach project invite to user", "error", err, "inviteId", invite.ID)
+			continue
+		}
+
+		err = useCase.projectInviteRepository.Save(invite)
+		if err != nil {
+			slog.Error("Unable to save project invite attached to user", "error", err, "inviteId", invite.ID)
+		}
+	}
+}
@@ -15,7 +15,8 @@ import (
 func TestVerifyUserUseCase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	userRepositoryMock := repository.NewMockUserRepository(ctrl)
-	useCase := NewVerifyUserUseCase(userRepositoryMock)
+	projectInviteRepositoryMock := repository.NewMockProjectInviteRepository(ctrl)
+	useCase := NewVerifyUserUseCase(userRepositoryMock, projectInviteRepositoryMock)
 
 	req := &model.VerifyUserRequest{
 		UserID:            "fake-user-id",
@@ -85,7 +86,40 @@ func TestVerifyUserUseCase_Execute(t *testing.T) {
 		EXPECT().
 		Save(user).
 		Return(nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ListPendingUnattachedByEmail("john.doe@gmail.com").
+		Return(nil, errors.New("unexpected error"))
+
+	err = useCase.Execute(req)
+	assert.Nil(t, err)
+
+	project, _ := factory.NewProjectWithDefaultOwner("fake project")
+	invite, _ := domainmodel.NewProjectInviteByEmail(project, "John.Doe@gmail.com", domainmodel.ProjectRoleViewer, project.Memberships[0].User)
+	answeredInvite, _ := domainmodel.NewProjectInviteByEmail(project, "john.doe@gmail.com", domainmodel.ProjectRoleViewer, project.Memberships[0].User)
+	answeredInvite.Status = domainmodel.ProjectInviteStatusDeclined
+
+	user, _ = domainmodel.NewUser("john.doe@gmail.com", "John Doe", "fake-password")
+	req.VerificationToken = *user.VerificationToken
+	userRepositoryMock.
+		EXPECT().
+		FindById(req.UserID).
+		Return(user, nil)
+	userRepositoryMock.
+		EXPECT().
+		Save(user).
+		Return(nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		ListPendingUnattachedByEmail("john.doe@gmail.com").
+		Return([]*domainmodel.ProjectInvite{invite, answeredInvite}, nil)
+	projectInviteRepositoryMock.
+		EXPECT().
+		Save(invite).
+		Return(nil)
 
 	err = useCase.Execute(req)
 	assert.Nil(t, err)
+	assert.Equal(t, user.ID, *invite.UserID)
+	assert.Nil(t, answeredInvite.UserID)
 }
@@ -2,8 +2,10 @@ package rest
 
 import (
 	"github.com/RuanScherer/journey-track-api/adapters/restadptr"
+	"github.com/RuanScherer/journey-track-api/adapters/scheduleradptr"
 )
 
 func StartAPI() {
+	scheduleradptr.StartScheduler()
 	restadptr.StartServer()
 }
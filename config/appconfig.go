@@ -1,6 +1,11 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type AppConfig struct {
 	Environment string `mapstructure:"ENVIRONMENT"`
@@ -10,10 +15,33 @@ type AppConfig struct {
 	DbLogEnabled bool   `mapstructure:"DB_LOG_ENABLED"`
 
 	RestApiPort uint `mapstructure:"REST_API_PORT"`
+	// CorsAllowedOrigins is a comma separated list of origins allowed to call the dashboard api
+	CorsAllowedOrigins string `mapstructure:"CORS_ALLOWED_ORIGINS"`
 
 	JwtSecret string `mapstructure:"JWT_SECRET"`
 
 	KafkaBootstrapServers string `mapstructure:"KAFKA_BOOTSTRAP_SERVERS"`
+
+	EventMaxFutureDrift time.Duration `mapstructure:"EVENT_MAX_FUTURE_DRIFT"`
+	EventMaxPastAge     time.Duration `mapstructure:"EVENT_MAX_PAST_AGE"`
+
+	EventDeduplicationWindow time.Duration `mapstructure:"EVENT_DEDUPLICATION_WINDOW"`
+	EventSessionTimeout      time.Duration `mapstructure:"EVENT_SESSION_TIMEOUT"`
+	EventSignatureTolerance  time.Duration `mapstructure:"EVENT_SIGNATURE_TOLERANCE"`
+	// EventMonthlyQuota applies to projects without their own quota, 0 means unlimited
+	EventMonthlyQuota int `mapstructure:"EVENT_MONTHLY_QUOTA"`
+
+	// RateLimitStore is either memory, for a single instance, or postgres, to share limits between instances
+	RateLimitStore                   string  `mapstructure:"RATE_LIMIT_STORE"`
+	IngestionRateLimitPerApiKey      float64 `mapstructure:"INGESTION_RATE_LIMIT_PER_API_KEY"`
+	IngestionRateLimitPerApiKeyBurst int     `mapstructure:"INGESTION_RATE_LIMIT_PER_API_KEY_BURST"`
+	IngestionRateLimitPerIp          float64 `mapstructure:"INGESTION_RATE_LIMIT_PER_IP"`
+	IngestionRateLimitPerIpBurst     int     `mapstructure:"INGESTION_RATE_LIMIT_PER_IP_BURST"`
+	// RateLimitPruneInterval is how often refilled buckets are deleted from the postgres store, 0 disables it
+	RateLimitPruneInterval time.Duration `mapstructure:"RATE_LIMIT_PRUNE_INTERVAL"`
+
+	// ProjectInviteReminderInterval is how often expiring invites are looked up, 0 disables the reminders
+	ProjectInviteReminderInterval time.Duration `mapstructure:"PROJECT_INVITE_REMINDER_INTERVAL"`
 }
 
 var config *AppConfig
@@ -33,6 +61,19 @@ func loadConfig() *AppConfig {
 
 	viper.SetDefault("DB_LOG_ENABLED", false)
 	viper.SetDefault("REST_API_PORT", 3000)
+	viper.SetDefault("EVENT_MAX_FUTURE_DRIFT", "1h")
+	viper.SetDefault("EVENT_MAX_PAST_AGE", "720h")
+	viper.SetDefault("EVENT_DEDUPLICATION_WINDOW", "24h")
+	viper.SetDefault("EVENT_SESSION_TIMEOUT", "30m")
+	viper.SetDefault("EVENT_SIGNATURE_TOLERANCE", "5m")
+	viper.SetDefault("EVENT_MONTHLY_QUOTA", 0)
+	viper.SetDefault("RATE_LIMIT_STORE", "memory")
+	viper.SetDefault("INGESTION_RATE_LIMIT_PER_API_KEY", 100)
+	viper.SetDefault("INGESTION_RATE_LIMIT_PER_API_KEY_BURST", 200)
+	viper.SetDefault("INGESTION_RATE_LIMIT_PER_IP", 20)
+	viper.SetDefault("INGESTION_RATE_LIMIT_PER_IP_BURST", 50)
+	viper.SetDefault("RATE_LIMIT_PRUNE_INTERVAL", "10m")
+	viper.SetDefault("PROJECT_INVITE_REMINDER_INTERVAL", "1h")
 
 	err := viper.ReadInConfig()
 	if err != nil {
@@ -45,3 +86,19 @@ func loadConfig() *AppConfig {
 	}
 	return config
 }
+
+// GetCorsAllowedOrigins defaults to the frontend url when no origin is configured
+func (appConfig *AppConfig) GetCorsAllowedOrigins() []string {
+	allowedOrigins := make([]string, 0)
+	for _, origin := range strings.Split(appConfig.CorsAllowedOrigins, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin != "" {
+			allowedOrigins = append(allowedOrigins, origin)
+		}
+	}
+
+	if len(allowedOrigins) == 0 && appConfig.FrontendUrl != "" {
+		allowedOrigins = append(allowedOrigins, appConfig.FrontendUrl)
+	}
+	return allowedOrigins
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"errors"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EndUserIdentity attributes the events sent with an anonymous ID before login to a known distinct ID
+type EndUserIdentity struct {
+	gorm.Model
+	ID          string   `json:"id" gorm:"primaryKey" valid:"uuid~[end user identity] Invalid ID"`
+	ProjectID   string   `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;uniqueIndex:idx_end_user_identities_project_anonymous_id,priority:1" valid:"required~[end user identity] Project is required"`
+	Project     *Project `json:"project" valid:"-"`
+	AnonymousID string   `json:"anonymous_id" gorm:"column:anonymous_id;type:varchar(255);not null;uniqueIndex:idx_end_user_identities_project_anonymous_id,priority:2" valid:"required~[end user identity] Anonymous ID is required,maxstringlength(255)~[end user identity] Anonymous ID too long"`
+	DistinctID  string   `json:"distinct_id" gorm:"column:distinct_id;type:varchar(255);not null" valid:"required~[end user identity] Distinct ID is required,maxstringlength(255)~[end user identity] Distinct ID too long"`
+}
+
+func NewEndUserIdentity(project *Project, anonymousID string, distinctID string) (*EndUserIdentity, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if anonymousID != "" && anonymousID == distinctID {
+		return nil, errors.New("[end user identity] Anonymous ID and distinct ID should be different")
+	}
+
+	identity := &EndUserIdentity{
+		ID:          uuid.New().String(),
+		ProjectID:   project.ID,
+		Project:     project,
+		AnonymousID: anonymousID,
+		DistinctID:  distinctID,
+	}
+
+	_, err = govalidator.ValidateStruct(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
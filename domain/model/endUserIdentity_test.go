@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEndUserIdentity(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when provided project is invalid", func(t *testing.T) {
+		_, err := NewEndUserIdentity(&Project{}, "anonymous-1", "user-1")
+		require.NotNil(t, err)
+	})
+
+	t.Run("should get error when provided IDs are invalid", func(t *testing.T) {
+		_, err := NewEndUserIdentity(project, "", "user-1")
+		require.NotNil(t, err)
+		require.Equal(t, "[end user identity] Anonymous ID is required", err.Error())
+
+		_, err = NewEndUserIdentity(project, "anonymous-1", "")
+		require.NotNil(t, err)
+		require.Equal(t, "[end user identity] Distinct ID is required", err.Error())
+
+		_, err = NewEndUserIdentity(project, "user-1", "user-1")
+		require.NotNil(t, err)
+		require.Equal(t, "[end user identity] Anonymous ID and distinct ID should be different", err.Error())
+	})
+
+	t.Run("should create end user identity", func(t *testing.T) {
+		identity, err := NewEndUserIdentity(project, "anonymous-1", "user-1")
+		require.Nil(t, err)
+		require.NotEmpty(t, identity.ID)
+		require.Equal(t, project.ID, identity.ProjectID)
+		require.Equal(t, "anonymous-1", identity.AnonymousID)
+		require.Equal(t, "user-1", identity.DistinctID)
+	})
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type EndUserSession struct {
+	gorm.Model
+	ID              string    `json:"id" gorm:"primaryKey" valid:"uuid~[end user session] Invalid ID"`
+	ProjectID       string    `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;index:idx_end_user_sessions_project_started_at,priority:1;index:idx_end_user_sessions_project_end_user_id,priority:1;uniqueIndex:idx_end_user_sessions_project_client_session_id,priority:1" valid:"required~[end user session] Project is required"`
+	Project         *Project  `json:"project" valid:"-"`
+	EndUserID       string    `json:"end_user_id" gorm:"column:end_user_id;type:varchar(255);not null;default:'';index:idx_end_user_sessions_project_end_user_id,priority:2" valid:"-"`
+	ClientSessionID *string   `json:"client_session_id" gorm:"column:client_session_id;type:varchar(255);default:null;uniqueIndex:idx_end_user_sessions_project_client_session_id,priority:2" valid:"-"`
+	StartedAt       time.Time `json:"started_at" gorm:"not null;index:idx_end_user_sessions_project_started_at,priority:2" valid:"-"`
+	EndedAt         time.Time `json:"ended_at" gorm:"not null" valid:"-"`
+	DurationSeconds float64   `json:"duration_seconds" gorm:"not null;default:0" valid:"-"`
+	EventCount      int       `json:"event_count" gorm:"not null;default:0" valid:"-"`
+	EntryEvent      string    `json:"entry_event" gorm:"type:varchar(255);not null" valid:"-"`
+	ExitEvent       string    `json:"exit_event" gorm:"type:varchar(255);not null" valid:"-"`
+}
+
+// NewEndUserSession starts a session with the given event, which should have been sent with a session ID
+// or an end user ID
+func NewEndUserSession(event *Event) (*EndUserSession, error) {
+	if event.Project == nil {
+		return nil, errors.New("[end user session] Project is required")
+	}
+
+	if event.SessionID == nil && event.EndUserID() == "" {
+		return nil, errors.New("[end user session] Session ID or end user ID is required")
+	}
+
+	session := &EndUserSession{
+		ID:              uuid.New().String(),
+		ProjectID:       event.Project.ID,
+		Project:         event.Project,
+		EndUserID:       event.EndUserID(),
+		ClientSessionID: event.SessionID,
+		StartedAt:       *event.Timestamp,
+		EndedAt:         *event.Timestamp,
+		EventCount:      1,
+		EntryEvent:      event.Name,
+		ExitEvent:       event.Name,
+	}
+
+	_, err := govalidator.ValidateStruct(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Covers tells whether an event at the given timestamp happened close enough to the session to be part of it
+func (session *EndUserSession) Covers(timestamp time.Time, inactivityTimeout time.Duration) bool {
+	return !timestamp.Before(session.StartedAt.Add(-inactivityTimeout)) &&
+		!timestamp.After(session.EndedAt.Add(inactivityTimeout))
+}
+
+func (session *EndUserSession) Track(event *Event) {
+	if event.Timestamp.Before(session.StartedAt) {
+		session.StartedAt = *event.Timestamp
+		session.EntryEvent = event.Name
+	}
+	if !event.Timestamp.Before(session.EndedAt) {
+		session.EndedAt = *event.Timestamp
+		session.ExitEvent = event.Name
+	}
+	if session.EndUserID == "" {
+		session.EndUserID = event.EndUserID()
+	}
+
+	session.EventCount++
+	session.DurationSeconds = session.EndedAt.Sub(session.StartedAt).Seconds()
+}
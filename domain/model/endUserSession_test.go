@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEndUserSession(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when event has no session or end user ID", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		_, err := NewEndUserSession(event)
+		require.NotNil(t, err)
+		require.Equal(t, "[end user session] Session ID or end user ID is required", err.Error())
+	})
+
+	t.Run("should create end user session", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+		_ = event.Identify("user-1", "")
+
+		session, err := NewEndUserSession(event)
+		require.Nil(t, err)
+		require.NotEmpty(t, session.ID)
+		require.Equal(t, project.ID, session.ProjectID)
+		require.Equal(t, "user-1", session.EndUserID)
+		require.Nil(t, session.ClientSessionID)
+		require.Equal(t, *event.Timestamp, session.StartedAt)
+		require.Equal(t, *event.Timestamp, session.EndedAt)
+		require.Equal(t, 1, session.EventCount)
+		require.Equal(t, "Test", session.EntryEvent)
+		require.Equal(t, "Test", session.ExitEvent)
+	})
+}
+
+func TestEndUserSession_Track(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(name string, timestamp time.Time) *Event {
+		event, _ := NewEvent(name, nil, project)
+		event.Timestamp = &timestamp
+		_ = event.AssignSessionID("session-id")
+		return event
+	}
+	session, _ := NewEndUserSession(newEvent("pricing", start))
+
+	require.True(t, session.Covers(start.Add(-30*time.Minute), 30*time.Minute))
+	require.False(t, session.Covers(start.Add(31*time.Minute), 30*time.Minute))
+
+	session.Track(newEvent("purchase", start.Add(10*time.Minute)))
+	session.Track(newEvent("home", start.Add(-5*time.Minute)))
+	require.Equal(t, 3, session.EventCount)
+	require.Equal(t, "home", session.EntryEvent)
+	require.Equal(t, "purchase", session.ExitEvent)
+	require.Equal(t, start.Add(-5*time.Minute), session.StartedAt)
+	require.Equal(t, start.Add(10*time.Minute), session.EndedAt)
+	require.Equal(t, (15 * time.Minute).Seconds(), session.DurationSeconds)
+}
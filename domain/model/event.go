@@ -1,6 +1,11 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/asaskevich/govalidator"
@@ -8,27 +13,53 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	EventPropertiesMaxSize  = 8 * 1024
+	EventPropertiesMaxKeys  = 100
+	EventPropertiesMaxDepth = 3
+)
+
+var eventPropertyKeyRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,63}$`)
+
 type Event struct {
 	gorm.Model
-	ID        string     `json:"id" gorm:"primaryKey" valid:"uuid~[event] Invalid ID"`
-	Name      string     `json:"name" gorm:"type:varchar(255);not null" valid:"required~[event] Name is required,minstringlength(1)~[event] Name is required,minstringlength(2)~[event] Name should be longer than 2 characters"`
-	Timestamp *time.Time `json:"timestamp" gorm:"type:timestamp with time zone;not null;default:NOW()" valid:"required~[event] Timestamp is required"`
-	ProjectID string     `json:"project_id" gorm:"column:project_id;type:varchar(255);not null" valid:"-"`
-	Project   *Project   `json:"project" valid:"-"`
+	ID              string          `json:"id" gorm:"primaryKey" valid:"uuid~[event] Invalid ID"`
+	Name            string          `json:"name" gorm:"type:varchar(255);not null" valid:"required~[event] Name is required,minstringlength(1)~[event] Name is required,minstringlength(2)~[event] Name should be longer than 2 characters"`
+	Timestamp       *time.Time      `json:"timestamp" gorm:"type:timestamp with time zone;not null;default:NOW();index:idx_events_project_timestamp,priority:2" valid:"required~[event] Timestamp is required"`
+	ClientTimestamp *time.Time      `json:"client_timestamp" gorm:"column:client_timestamp;type:timestamp with time zone;default:null" valid:"-"`
+	ReceivedAt      *time.Time      `json:"received_at" gorm:"column:received_at;type:timestamp with time zone;not null;default:NOW()" valid:"required~[event] Received at is required"`
+	ClientEventID   *string         `json:"client_event_id" gorm:"column:client_event_id;type:varchar(255);default:null;uniqueIndex:idx_events_project_client_event_id,priority:2" valid:"-"`
+	DistinctID      *string         `json:"distinct_id" gorm:"column:distinct_id;type:varchar(255);default:null;index:idx_events_project_distinct_id,priority:2" valid:"-"`
+	AnonymousID     *string         `json:"anonymous_id" gorm:"column:anonymous_id;type:varchar(255);default:null" valid:"-"`
+	SessionID       *string         `json:"session_id" gorm:"column:session_id;type:varchar(255);default:null" valid:"-"`
+	Properties      EventProperties `json:"properties" gorm:"type:jsonb;not null;default:'{}'" valid:"-"`
+	ProjectID       string          `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;uniqueIndex:idx_events_project_client_event_id,priority:1;index:idx_events_project_timestamp,priority:1;index:idx_events_project_distinct_id,priority:1" valid:"-"`
+	Project         *Project        `json:"project" valid:"-"`
+}
+
+type EventTimestampLimits struct {
+	MaxFutureDrift time.Duration
+	MaxPastAge     time.Duration
 }
 
-func NewEvent(name string, project *Project) (*Event, error) {
+func NewEvent(name string, properties map[string]any, project *Project) (*Event, error) {
 	_, err := govalidator.ValidateStruct(project)
 	if err != nil {
 		return nil, err
 	}
 
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
 	eventTimestamp := time.Now()
 	event := &Event{
-		ID:        uuid.New().String(),
-		Name:      name,
-		Timestamp: &eventTimestamp,
-		Project:   project,
+		ID:         uuid.New().String(),
+		Name:       name,
+		Timestamp:  &eventTimestamp,
+		ReceivedAt: &eventTimestamp,
+		Properties: properties,
+		Project:    project,
 	}
 
 	_, err = govalidator.ValidateStruct(event)
@@ -36,5 +67,177 @@ func NewEvent(name string, project *Project) (*Event, error) {
 		return nil, err
 	}
 
+	err = event.Properties.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return event, nil
 }
+
+// ApplyClientTimestamp uses the time the event happened on the client instead of the time it was received.
+// When sentAt is provided, the difference between it and receivedAt is used to correct the client clock skew.
+func (event *Event) ApplyClientTimestamp(
+	clientTimestamp time.Time,
+	sentAt *time.Time,
+	receivedAt time.Time,
+	limits EventTimestampLimits,
+) error {
+	correctedTimestamp := clientTimestamp
+	if sentAt != nil {
+		correctedTimestamp = clientTimestamp.Add(receivedAt.Sub(*sentAt))
+	}
+
+	if correctedTimestamp.After(receivedAt.Add(limits.MaxFutureDrift)) {
+		return errors.New("[event] Timestamp is too far in the future")
+	}
+
+	if correctedTimestamp.Before(receivedAt.Add(-limits.MaxPastAge)) {
+		return errors.New("[event] Timestamp is too far in the past")
+	}
+
+	event.ClientTimestamp = &clientTimestamp
+	event.Timestamp = &correctedTimestamp
+	event.ReceivedAt = &receivedAt
+	return nil
+}
+
+func (event *Event) AssignClientEventID(clientEventID string) error {
+	if clientEventID == "" {
+		return errors.New("[event] Client event ID is required")
+	}
+
+	if len(clientEventID) > 255 {
+		return errors.New("[event] Client event ID should have at most 255 characters")
+	}
+
+	event.ClientEventID = &clientEventID
+	return nil
+}
+
+func (event *Event) AssignSessionID(sessionID string) error {
+	if sessionID == "" {
+		return errors.New("[event] Session ID is required")
+	}
+
+	if len(sessionID) > 255 {
+		return errors.New("[event] Session ID should have at most 255 characters")
+	}
+
+	event.SessionID = &sessionID
+	return nil
+}
+
+func (event *Event) Identify(distinctID string, anonymousID string) error {
+	if len(distinctID) > 255 {
+		return errors.New("[event] Distinct ID should have at most 255 characters")
+	}
+
+	if len(anonymousID) > 255 {
+		return errors.New("[event] Anonymous ID should have at most 255 characters")
+	}
+
+	if distinctID != "" {
+		event.DistinctID = &distinctID
+	}
+	if anonymousID != "" {
+		event.AnonymousID = &anonymousID
+	}
+	return nil
+}
+
+// EndUserID returns the ID the event was sent with, the distinct ID when known and the anonymous ID otherwise
+func (event *Event) EndUserID() string {
+	if event.DistinctID != nil {
+		return *event.DistinctID
+	}
+	if event.AnonymousID != nil {
+		return *event.AnonymousID
+	}
+	return ""
+}
+
+type EventProperties map[string]any
+
+func IsValidEventPropertyKey(key string) bool {
+	return eventPropertyKeyRegex.MatchString(key)
+}
+
+func (properties EventProperties) Validate() error {
+	if len(properties) > EventPropertiesMaxKeys {
+		return fmt.Errorf("[event] Properties should have at most %d keys", EventPropertiesMaxKeys)
+	}
+
+	err := validateEventPropertyValue(map[string]any(properties), 0)
+	if err != nil {
+		return err
+	}
+
+	encodedProperties, err := json.Marshal(properties)
+	if err != nil {
+		return errors.New("[event] Properties should be valid JSON")
+	}
+
+	if len(encodedProperties) > EventPropertiesMaxSize {
+		return fmt.Errorf("[event] Properties should be smaller than %d bytes", EventPropertiesMaxSize)
+	}
+	return nil
+}
+
+func validateEventPropertyValue(value any, depth int) error {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		if depth >= EventPropertiesMaxDepth {
+			return fmt.Errorf("[event] Properties should be nested at most %d levels deep", EventPropertiesMaxDepth)
+		}
+		for key, nestedValue := range typedValue {
+			if !IsValidEventPropertyKey(key) {
+				return fmt.Errorf("[event] Invalid property key %q", key)
+			}
+			err := validateEventPropertyValue(nestedValue, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	case []any:
+		if depth >= EventPropertiesMaxDepth {
+			return fmt.Errorf("[event] Properties should be nested at most %d levels deep", EventPropertiesMaxDepth)
+		}
+		for _, nestedValue := range typedValue {
+			err := validateEventPropertyValue(nestedValue, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (properties EventProperties) Value() (driver.Value, error) {
+	if properties == nil {
+		return "{}", nil
+	}
+
+	encodedProperties, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	return string(encodedProperties), nil
+}
+
+func (properties *EventProperties) Scan(value any) error {
+	var encodedProperties []byte
+	switch typedValue := value.(type) {
+	case nil:
+		*properties = EventProperties{}
+		return nil
+	case []byte:
+		encodedProperties = typedValue
+	case string:
+		encodedProperties = []byte(typedValue)
+	default:
+		return fmt.Errorf("unsupported type %T for event properties", value)
+	}
+
+	return json.Unmarshal(encodedProperties, properties)
+}
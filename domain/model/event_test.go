@@ -1,7 +1,10 @@
 package model
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +16,7 @@ func TestNewEvent(t *testing.T) {
 		project, err := NewProject("", projectOwner)
 		require.NotNil(t, err)
 
-		_, err = NewEvent("Event", project)
+		_, err = NewEvent("Event", nil, project)
 		require.NotNil(t, err)
 	})
 
@@ -22,23 +25,210 @@ func TestNewEvent(t *testing.T) {
 		_ = projectOwner.Verify(*projectOwner.VerificationToken)
 		project, _ := NewProject("Test", projectOwner)
 
-		_, err := NewEvent("", project)
+		_, err := NewEvent("", nil, project)
 		require.NotNil(t, err)
 		require.Equal(t, "[event] Name is required", err.Error())
 
-		_, err = NewEvent("A", project)
+		_, err = NewEvent("A", nil, project)
 		require.NotNil(t, err)
 		require.Equal(t, "[event] Name should be longer than 2 characters", err.Error())
 	})
 
+	t.Run("should get error when provided properties are invalid", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("Test", projectOwner)
+
+		_, err := NewEvent("Test", map[string]any{"invalid key": "value"}, project)
+		require.NotNil(t, err)
+		require.Equal(t, `[event] Invalid property key "invalid key"`, err.Error())
+
+		_, err = NewEvent("Test", map[string]any{"a": map[string]any{"b": map[string]any{"c": map[string]any{}}}}, project)
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Properties should be nested at most 3 levels deep", err.Error())
+
+		_, err = NewEvent("Test", map[string]any{"value": strings.Repeat("a", EventPropertiesMaxSize)}, project)
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Properties should be smaller than 8192 bytes", err.Error())
+
+		tooManyProperties := map[string]any{}
+		for i := 0; i <= EventPropertiesMaxKeys; i++ {
+			tooManyProperties[fmt.Sprintf("key_%d", i)] = i
+		}
+		_, err = NewEvent("Test", tooManyProperties, project)
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Properties should have at most 100 keys", err.Error())
+	})
+
 	t.Run("should create event", func(t *testing.T) {
 		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
 		_ = projectOwner.Verify(*projectOwner.VerificationToken)
 		project, _ := NewProject("Test", projectOwner)
 
-		event, err := NewEvent("Test", project)
+		event, err := NewEvent("Test", nil, project)
 		require.Nil(t, err)
 		require.NotNil(t, event)
 		require.NotNil(t, event.Timestamp)
+		require.NotNil(t, event.Properties)
+		require.Empty(t, event.Properties)
+
+		event, err = NewEvent("Test", map[string]any{"plan": "pro", "button": map[string]any{"id": "signup"}}, project)
+		require.Nil(t, err)
+		require.Equal(t, "pro", event.Properties["plan"])
 	})
 }
+
+func TestApplyClientTimestamp(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+	limits := EventTimestampLimits{MaxFutureDrift: time.Hour, MaxPastAge: 24 * time.Hour}
+	receivedAt := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should get error when timestamp is too far in the future", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.ApplyClientTimestamp(receivedAt.Add(2*time.Hour), nil, receivedAt, limits)
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Timestamp is too far in the future", err.Error())
+	})
+
+	t.Run("should get error when timestamp is too far in the past", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.ApplyClientTimestamp(receivedAt.Add(-25*time.Hour), nil, receivedAt, limits)
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Timestamp is too far in the past", err.Error())
+	})
+
+	t.Run("should keep client timestamp when sent at is not provided", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+		clientTimestamp := receivedAt.Add(-time.Hour)
+
+		err := event.ApplyClientTimestamp(clientTimestamp, nil, receivedAt, limits)
+		require.Nil(t, err)
+		require.Equal(t, clientTimestamp, *event.ClientTimestamp)
+		require.Equal(t, clientTimestamp, *event.Timestamp)
+		require.Equal(t, receivedAt, *event.ReceivedAt)
+	})
+
+	t.Run("should correct client clock skew using sent at", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+		// client clock is 3 hours ahead, event happened 10 minutes before it was sent
+		sentAt := receivedAt.Add(3 * time.Hour)
+		clientTimestamp := sentAt.Add(-10 * time.Minute)
+
+		err := event.ApplyClientTimestamp(clientTimestamp, &sentAt, receivedAt, limits)
+		require.Nil(t, err)
+		require.Equal(t, clientTimestamp, *event.ClientTimestamp)
+		require.Equal(t, receivedAt.Add(-10*time.Minute), *event.Timestamp)
+	})
+}
+
+func TestAssignClientEventID(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when provided client event ID is invalid", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.AssignClientEventID("")
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Client event ID is required", err.Error())
+
+		err = event.AssignClientEventID(strings.Repeat("a", 256))
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Client event ID should have at most 255 characters", err.Error())
+		require.Nil(t, event.ClientEventID)
+	})
+
+	t.Run("should assign client event ID", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.AssignClientEventID("client-event-id")
+		require.Nil(t, err)
+		require.Equal(t, "client-event-id", *event.ClientEventID)
+	})
+}
+
+func TestAssignSessionID(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when provided session ID is invalid", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.AssignSessionID("")
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Session ID is required", err.Error())
+
+		err = event.AssignSessionID(strings.Repeat("a", 256))
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Session ID should have at most 255 characters", err.Error())
+		require.Nil(t, event.SessionID)
+	})
+
+	t.Run("should assign session ID", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.AssignSessionID("session-id")
+		require.Nil(t, err)
+		require.Equal(t, "session-id", *event.SessionID)
+	})
+}
+
+func TestIdentify(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when provided IDs are invalid", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.Identify(strings.Repeat("a", 256), "")
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Distinct ID should have at most 255 characters", err.Error())
+
+		err = event.Identify("user-1", strings.Repeat("a", 256))
+		require.NotNil(t, err)
+		require.Equal(t, "[event] Anonymous ID should have at most 255 characters", err.Error())
+		require.Nil(t, event.DistinctID)
+		require.Nil(t, event.AnonymousID)
+	})
+
+	t.Run("should keep IDs that are not provided empty", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.Identify("", "anonymous-1")
+		require.Nil(t, err)
+		require.Nil(t, event.DistinctID)
+		require.Equal(t, "anonymous-1", *event.AnonymousID)
+		require.Equal(t, "anonymous-1", event.EndUserID())
+	})
+
+	t.Run("should identify event", func(t *testing.T) {
+		event, _ := NewEvent("Test", nil, project)
+
+		err := event.Identify("user-1", "anonymous-1")
+		require.Nil(t, err)
+		require.Equal(t, "user-1", *event.DistinctID)
+		require.Equal(t, "anonymous-1", *event.AnonymousID)
+		require.Equal(t, "user-1", event.EndUserID())
+	})
+}
+
+func TestEventProperties_Scan(t *testing.T) {
+	properties := EventProperties{}
+	err := properties.Scan([]byte(`{"plan":"pro"}`))
+	require.Nil(t, err)
+	require.Equal(t, "pro", properties["plan"])
+
+	value, err := properties.Value()
+	require.Nil(t, err)
+	require.Equal(t, `{"plan":"pro"}`, value)
+
+	err = properties.Scan(10)
+	require.NotNil(t, err)
+}
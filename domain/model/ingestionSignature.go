@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IngestionSignature records an accepted request signature so the same signed request can't be replayed
+type IngestionSignature struct {
+	gorm.Model
+	ID        string    `json:"id" gorm:"primaryKey" valid:"uuid~[ingestion signature] Invalid ID"`
+	ProjectID string    `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;uniqueIndex:idx_ingestion_signatures_project_signature,priority:1" valid:"required~[ingestion signature] Project is required"`
+	Project   *Project  `json:"project" valid:"-"`
+	Signature string    `json:"signature" gorm:"type:varchar(255);not null;uniqueIndex:idx_ingestion_signatures_project_signature,priority:2" valid:"required~[ingestion signature] Signature is required,maxstringlength(255)~[ingestion signature] Signature too long"`
+	SignedAt  time.Time `json:"signed_at" gorm:"not null;index" valid:"-"`
+}
+
+func NewIngestionSignature(project *Project, signature string, signedAt time.Time) (*IngestionSignature, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, err
+	}
+
+	ingestionSignature := &IngestionSignature{
+		ID:        uuid.New().String(),
+		ProjectID: project.ID,
+		Project:   project,
+		Signature: signature,
+		SignedAt:  signedAt,
+	}
+
+	_, err = govalidator.ValidateStruct(ingestionSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return ingestionSignature, nil
+}
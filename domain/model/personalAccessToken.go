@@ -0,0 +1,130 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	PersonalAccessTokenScopeRead  = "read"
+	PersonalAccessTokenScopeWrite = "write"
+
+	PersonalAccessTokenPrefix       = "jtp_"
+	personalAccessTokenDisplayChars = 12
+	PersonalAccessTokenMaxTTL       = 365 * 24 * time.Hour
+	// last used timestamps are only refreshed once per interval to avoid a write on every request
+	PersonalAccessTokenLastUsedAtResolution = time.Minute
+)
+
+// PersonalAccessToken lets scripts act as the user through the management api, write scope includes read
+type PersonalAccessToken struct {
+	gorm.Model
+	ID     string `json:"id" gorm:"primaryKey" valid:"uuid~[personal access token] Invalid ID"`
+	UserID string `json:"user_id" gorm:"column:user_id;type:varchar(255);not null;index" valid:"required~[personal access token] User is required"`
+	User   *User  `json:"user" valid:"-"`
+	Name   string `json:"name" gorm:"type:varchar(255);not null" valid:"required~[personal access token] Name is required,maxstringlength(255)~[personal access token] Name too long"`
+	Scope  string `json:"scope" gorm:"type:varchar(50);not null" valid:"required~[personal access token] Scope is required,in(read|write)~[personal access token] Invalid scope"`
+	// ProjectID restricts the token to a single project when set
+	ProjectID  *string    `json:"project_id" gorm:"column:project_id;type:varchar(255);default:null" valid:"-"`
+	Prefix     string     `json:"prefix" gorm:"type:varchar(50);not null" valid:"-"`
+	TokenHash  string     `json:"-" gorm:"column:token_hash;type:varchar(255);not null;uniqueIndex" valid:"-"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"default:null" valid:"-"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null" valid:"-"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"default:null" valid:"-"`
+}
+
+// NewPersonalAccessToken returns the token along with its plain value, which is only stored hashed
+func NewPersonalAccessToken(
+	user *User,
+	name string,
+	scope string,
+	project *Project,
+	expiresAt time.Time,
+) (*PersonalAccessToken, string, error) {
+	if !user.IsVerified {
+		return nil, "", errors.New("[personal access token] User must be verified")
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, "", errors.New("[personal access token] Expiry should be in the future")
+	}
+
+	if expiresAt.After(now.Add(PersonalAccessTokenMaxTTL)) {
+		return nil, "", errors.New("[personal access token] Expiry should be within 365 days")
+	}
+
+	randomBytes := make([]byte, 24)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	plainToken := PersonalAccessTokenPrefix + hex.EncodeToString(randomBytes)
+
+	token := &PersonalAccessToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		User:      user,
+		Name:      name,
+		Scope:     scope,
+		Prefix:    plainToken[:personalAccessTokenDisplayChars],
+		TokenHash: HashPersonalAccessToken(plainToken),
+		ExpiresAt: expiresAt,
+	}
+	if project != nil {
+		token.ProjectID = &project.ID
+	}
+
+	_, err = govalidator.ValidateStruct(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, plainToken, nil
+}
+
+func HashPersonalAccessToken(plainToken string) string {
+	hash := sha256.Sum256([]byte(plainToken))
+	return hex.EncodeToString(hash[:])
+}
+
+func (token *PersonalAccessToken) IsActive(now time.Time) bool {
+	return token.RevokedAt == nil && now.Before(token.ExpiresAt)
+}
+
+func (token *PersonalAccessToken) AllowsWrite() bool {
+	return token.Scope == PersonalAccessTokenScopeWrite
+}
+
+// AllowsProject tells whether the token can reach the project, an empty project ID
+// stands for requests outside of any project
+func (token *PersonalAccessToken) AllowsProject(projectID string) bool {
+	return token.ProjectID == nil || *token.ProjectID == projectID
+}
+
+func (token *PersonalAccessToken) Revoke() error {
+	if token.RevokedAt != nil {
+		return errors.New("[personal access token] Token already revoked")
+	}
+
+	now := time.Now()
+	token.RevokedAt = &now
+	return nil
+}
+
+// MarkUsed tells whether the last used timestamp changed and should be stored
+func (token *PersonalAccessToken) MarkUsed(now time.Time) bool {
+	if token.LastUsedAt != nil && now.Sub(*token.LastUsedAt) < PersonalAccessTokenLastUsedAtResolution {
+		return false
+	}
+
+	token.LastUsedAt = &now
+	return true
+}
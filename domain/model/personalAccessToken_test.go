@@ -0,0 +1,78 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newVerifiedUserForPersonalAccessToken() *User {
+	user, _ := NewUser("john.doe@example.com", "John Doe", "pass1234")
+	_ = user.Verify(*user.VerificationToken)
+	return user
+}
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	t.Run("should get error when user is not verified", func(t *testing.T) {
+		user, _ := NewUser("john.doe@example.com", "John Doe", "pass1234")
+		_, _, err := NewPersonalAccessToken(user, "CI", PersonalAccessTokenScopeRead, nil, time.Now().Add(time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[personal access token] User must be verified", err.Error())
+	})
+
+	t.Run("should get error when expiry is not within the allowed range", func(t *testing.T) {
+		user := newVerifiedUserForPersonalAccessToken()
+		_, _, err := NewPersonalAccessToken(user, "CI", PersonalAccessTokenScopeRead, nil, time.Now().Add(-time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[personal access token] Expiry should be in the future", err.Error())
+
+		expiresAt := time.Now().Add(PersonalAccessTokenMaxTTL + time.Hour)
+		_, _, err = NewPersonalAccessToken(user, "CI", PersonalAccessTokenScopeRead, nil, expiresAt)
+		require.NotNil(t, err)
+		require.Equal(t, "[personal access token] Expiry should be within 365 days", err.Error())
+	})
+
+	t.Run("should get error when scope is invalid", func(t *testing.T) {
+		user := newVerifiedUserForPersonalAccessToken()
+		_, _, err := NewPersonalAccessToken(user, "CI", "admin", nil, time.Now().Add(time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[personal access token] Invalid scope", err.Error())
+	})
+
+	t.Run("should create token stored hashed", func(t *testing.T) {
+		user := newVerifiedUserForPersonalAccessToken()
+		project, _ := NewProject("test", user)
+		token, plainToken, err := NewPersonalAccessToken(
+			user,
+			"CI",
+			PersonalAccessTokenScopeWrite,
+			project,
+			time.Now().Add(time.Hour),
+		)
+		require.Nil(t, err)
+		require.True(t, strings.HasPrefix(plainToken, PersonalAccessTokenPrefix))
+		require.Equal(t, HashPersonalAccessToken(plainToken), token.TokenHash)
+		require.NotEqual(t, plainToken, token.TokenHash)
+		require.True(t, strings.HasPrefix(plainToken, token.Prefix))
+		require.Equal(t, project.ID, *token.ProjectID)
+		require.True(t, token.AllowsWrite())
+		require.True(t, token.AllowsProject(project.ID))
+		require.False(t, token.AllowsProject("fake-other-project-id"))
+		require.False(t, token.AllowsProject(""))
+	})
+}
+
+func TestPersonalAccessToken_Revoke(t *testing.T) {
+	t.Run("should revoke token only once", func(t *testing.T) {
+		token := &PersonalAccessToken{ExpiresAt: time.Now().Add(time.Hour)}
+		err := token.Revoke()
+		require.Nil(t, err)
+		require.False(t, token.IsActive(time.Now()))
+
+		err = token.Revoke()
+		require.NotNil(t, err)
+		require.Equal(t, "[personal access token] Token already revoked", err.Error())
+	})
+}
@@ -1,7 +1,16 @@
 package model
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/google/uuid"
@@ -13,12 +22,26 @@ type Project struct {
 	ID      string           `json:"id" gorm:"primaryKey" valid:"uuid~[project] Invalid ID"`
 	Name    string           `json:"name" gorm:"type:varchar(255);not null" valid:"required~[project] Name is required,minstringlength(2)~[project] Name too short"`
 	OwnerID string           `json:"owner_id" gorm:"column:owner_id;type:varchar(255);not null" valid:"required~[project] Owner is required,uuid~[project] Invalid owner ID"`
-	Members []*User          `json:"members" gorm:"many2many:user_projects" valid:"-"`
 	Invites []*ProjectInvite `json:"invites" gorm:"foreignKey:ProjectID" valid:"-"`
 	Events  []*Event         `json:"events" gorm:"foreignKey:ProjectID" valid:"-"`
-	Token   *string          `json:"token" gorm:"type:varchar(255);unique" valid:"required~[project] Token is required,uuid~[project] Invalid token"`
+	// Memberships maps the user_projects rows, so they're the only source of the project members and their roles
+	Memberships []*ProjectMember `json:"members" gorm:"foreignKey:ProjectID" valid:"-"`
+	// SigningSecret is kept in plain text since it's needed to verify ingestion signatures
+	SigningSecret          *string `json:"-" gorm:"type:varchar(255);default:null" valid:"-"`
+	RequireSignedIngestion bool    `json:"require_signed_ingestion" gorm:"not null;default:false" valid:"-"`
+	// AllowedOrigins lists the browser origins allowed to send events to the project
+	AllowedOrigins ProjectOrigins `json:"allowed_origins" gorm:"type:jsonb;not null;default:'[]'" valid:"-"`
+	// MonthlyEventQuota overrides the default quota of events tracked per month when set
+	MonthlyEventQuota *int `json:"monthly_event_quota" gorm:"default:null" valid:"-"`
 }
 
+const (
+	projectSigningSecretPrefix = "jts_"
+	ProjectMaxAllowedOrigins   = 50
+)
+
+type ProjectOrigins []string
+
 func NewProject(name string, owner *User) (*Project, error) {
 	_, err := govalidator.ValidateStruct(owner)
 	if err != nil {
@@ -29,13 +52,10 @@ func NewProject(name string, owner *User) (*Project, error) {
 		return nil, errors.New("owner must be verified")
 	}
 
-	token := uuid.New().String()
 	project := &Project{
 		ID:      uuid.New().String(),
 		Name:    name,
 		OwnerID: owner.ID,
-		Members: []*User{owner},
-		Token:   &token,
 	}
 
 	_, err = govalidator.ValidateStruct(project)
@@ -43,6 +63,12 @@ func NewProject(name string, owner *User) (*Project, error) {
 		return nil, err
 	}
 
+	ownerMembership, err := NewProjectMember(project, owner, ProjectRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	project.Memberships = []*ProjectMember{ownerMembership}
+
 	return project, nil
 }
 
@@ -52,7 +78,7 @@ func (project *Project) ChangeName(newName string) error {
 	return err
 }
 
-func (project *Project) AddMember(user *User) error {
+func (project *Project) AddMember(user *User, role string) error {
 	_, err := govalidator.ValidateStruct(user)
 	if err != nil {
 		return err
@@ -62,16 +88,202 @@ func (project *Project) AddMember(user *User) error {
 		return errors.New("user is already a member of the project")
 	}
 
-	project.Members = append(project.Members, user)
+	if role == ProjectRoleOwner {
+		return errors.New("[project member] Owner role can't be assigned")
+	}
+
+	membership, err := NewProjectMember(project, user, role)
+	if err != nil {
+		return err
+	}
+
+	project.Memberships = append(project.Memberships, membership)
 	_, err = govalidator.ValidateStruct(project)
 	return err
 }
 
 func (project *Project) HasMember(user *User) bool {
-	for _, member := range project.Members {
-		if member.ID == user.ID {
+	return project.hasMemberID(user.ID)
+}
+
+func (project *Project) hasMemberID(userID string) bool {
+	return project.FindMembership(userID) != nil
+}
+
+// RemoveMember fails for the owner, who has to transfer the project ownership first
+func (project *Project) RemoveMember(userID string) error {
+	if !project.hasMemberID(userID) {
+		return errors.New("user is not a member of the project")
+	}
+
+	if project.OwnerID == userID {
+		return errors.New("[project] Owner can't be removed before transferring the project ownership")
+	}
+
+	memberships := make([]*ProjectMember, 0, len(project.Memberships))
+	for _, membership := range project.Memberships {
+		if membership.UserID != userID {
+			memberships = append(memberships, membership)
+		}
+	}
+	project.Memberships = memberships
+	return nil
+}
+
+// TransferOwnership makes the member the project owner, keeping the previous owner as an admin
+func (project *Project) TransferOwnership(newOwnerID string) error {
+	newOwnerMembership := project.FindMembership(newOwnerID)
+	if newOwnerMembership == nil {
+		return errors.New("user is not a member of the project")
+	}
+
+	if project.OwnerID == newOwnerID {
+		return errors.New("[project] User already owns the project")
+	}
+
+	previousOwnerMembership := project.FindMembership(project.OwnerID)
+	if previousOwnerMembership != nil {
+		previousOwnerMembership.Role = ProjectRoleAdmin
+	}
+	newOwnerMembership.Role = ProjectRoleOwner
+	project.OwnerID = newOwnerID
+	return nil
+}
+
+// FindMembership returns nil when the user is not a member or the memberships were not loaded
+func (project *Project) FindMembership(userID string) *ProjectMember {
+	for _, membership := range project.Memberships {
+		if membership.UserID == userID {
+			return membership
+		}
+	}
+	return nil
+}
+
+// RotateSigningSecret replaces the secret used to sign ingestion requests, invalidating the previous one
+func (project *Project) RotateSigningSecret() (string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+
+	signingSecret := projectSigningSecretPrefix + hex.EncodeToString(randomBytes)
+	project.SigningSecret = &signingSecret
+	return signingSecret, nil
+}
+
+func (project *Project) ChangeSignedIngestionRequirement(required bool) error {
+	if required && project.SigningSecret == nil {
+		return errors.New("[project] Signing secret is required to enforce signed ingestion")
+	}
+
+	project.RequireSignedIngestion = required
+	return nil
+}
+
+// SignIngestionPayload returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using the project signing secret
+func (project *Project) SignIngestionPayload(timestamp string, body []byte) (string, error) {
+	if project.SigningSecret == nil {
+		return "", errors.New("[project] Signing secret not configured")
+	}
+
+	mac := hmac.New(sha256.New, []byte(*project.SigningSecret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (project *Project) VerifyIngestionSignature(timestamp string, body []byte, signature string) bool {
+	expectedSignature, err := project.SignIngestionPayload(timestamp, body)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(expectedSignature), []byte(signature))
+}
+
+func (project *Project) ChangeAllowedOrigins(origins []string) error {
+	if len(origins) > ProjectMaxAllowedOrigins {
+		return fmt.Errorf("[project] Should have at most %d allowed origins", ProjectMaxAllowedOrigins)
+	}
+
+	allowedOrigins := ProjectOrigins{}
+	for _, origin := range origins {
+		normalizedOrigin, err := NormalizeOrigin(origin)
+		if err != nil {
+			return err
+		}
+		if !allowedOrigins.Contains(normalizedOrigin) {
+			allowedOrigins = append(allowedOrigins, normalizedOrigin)
+		}
+	}
+
+	project.AllowedOrigins = allowedOrigins
+	return nil
+}
+
+func (project *Project) AllowsOrigin(origin string) bool {
+	normalizedOrigin, err := NormalizeOrigin(origin)
+	if err != nil {
+		return false
+	}
+	return project.AllowedOrigins.Contains(normalizedOrigin)
+}
+
+// NormalizeOrigin returns the origin in the "<scheme>://<host>[:<port>]" format browsers send in the Origin header
+func NormalizeOrigin(origin string) (string, error) {
+	parsedOrigin, err := url.Parse(strings.TrimSpace(origin))
+	invalidOriginErr := fmt.Errorf("[project] Invalid origin %q", origin)
+	if err != nil {
+		return "", invalidOriginErr
+	}
+
+	scheme := strings.ToLower(parsedOrigin.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", invalidOriginErr
+	}
+
+	hasPath := parsedOrigin.Path != "" && parsedOrigin.Path != "/"
+	if parsedOrigin.Host == "" || hasPath || parsedOrigin.RawQuery != "" || parsedOrigin.User != nil {
+		return "", invalidOriginErr
+	}
+	return scheme + "://" + strings.ToLower(parsedOrigin.Host), nil
+}
+
+func (origins ProjectOrigins) Contains(origin string) bool {
+	for _, allowedOrigin := range origins {
+		if allowedOrigin == origin {
 			return true
 		}
 	}
 	return false
 }
+
+func (origins ProjectOrigins) Value() (driver.Value, error) {
+	if origins == nil {
+		return "[]", nil
+	}
+
+	encodedOrigins, err := json.Marshal(origins)
+	if err != nil {
+		return nil, err
+	}
+	return string(encodedOrigins), nil
+}
+
+func (origins *ProjectOrigins) Scan(value any) error {
+	var encodedOrigins []byte
+	switch typedValue := value.(type) {
+	case nil:
+		*origins = ProjectOrigins{}
+		return nil
+	case []byte:
+		encodedOrigins = typedValue
+	case string:
+		encodedOrigins = []byte(typedValue)
+	default:
+		return fmt.Errorf("unsupported type %T for project origins", value)
+	}
+
+	return json.Unmarshal(encodedOrigins, origins)
+}
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ProjectApiKeyScopeIngest = "ingest"
+	ProjectApiKeyScopeRead   = "read"
+
+	projectApiKeyPrefix       = "jtk_"
+	ProjectApiKeyDisplayChars = 12
+	// ProjectApiKeyDefaultName names the ingest key every new project starts with
+	ProjectApiKeyDefaultName = "Default"
+	// ProjectApiKeyLegacyName names the ingest keys migrated from the tokens projects had before api keys
+	ProjectApiKeyLegacyName = "Legacy project token"
+	// last used timestamps are only refreshed once per interval to avoid a write on every request
+	ProjectApiKeyLastUsedAtResolution = time.Minute
+)
+
+type ProjectApiKey struct {
+	gorm.Model
+	ID          string     `json:"id" gorm:"primaryKey" valid:"uuid~[project api key] Invalid ID"`
+	ProjectID   string     `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;index" valid:"required~[project api key] Project is required"`
+	Project     *Project   `json:"project" valid:"-"`
+	Name        string     `json:"name" gorm:"type:varchar(255);not null" valid:"required~[project api key] Name is required,maxstringlength(255)~[project api key] Name too long"`
+	Scope       string     `json:"scope" gorm:"type:varchar(50);not null" valid:"required~[project api key] Scope is required,in(ingest|read)~[project api key] Invalid scope"`
+	Prefix      string     `json:"prefix" gorm:"type:varchar(50);not null" valid:"-"`
+	KeyHash     string     `json:"-" gorm:"column:key_hash;type:varchar(255);not null;uniqueIndex" valid:"-"`
+	CreatedByID string     `json:"created_by_id" gorm:"column:created_by_id;type:varchar(255);not null" valid:"required~[project api key] Creator is required"`
+	LastUsedAt  *time.Time `json:"last_used_at" gorm:"default:null" valid:"-"`
+	ExpiresAt   *time.Time `json:"expires_at" gorm:"default:null" valid:"-"`
+	RevokedAt   *time.Time `json:"revoked_at" gorm:"default:null" valid:"-"`
+	// AllowUnsigned lets browser ingest keys skip request signing when the project enforces it
+	AllowUnsigned bool `json:"allow_unsigned" gorm:"not null;default:false" valid:"-"`
+}
+
+// NewProjectApiKey returns the api key along with its plain value, which is only stored hashed
+func NewProjectApiKey(project *Project, name string, scope string, creator *User) (*ProjectApiKey, string, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = govalidator.ValidateStruct(creator)
+	if err != nil {
+		return nil, "", err
+	}
+
+	randomBytes := make([]byte, 24)
+	_, err = rand.Read(randomBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	plainKey := projectApiKeyPrefix + hex.EncodeToString(randomBytes)
+
+	apiKey := &ProjectApiKey{
+		ID:          uuid.New().String(),
+		ProjectID:   project.ID,
+		Project:     project,
+		Name:        name,
+		Scope:       scope,
+		Prefix:      plainKey[:ProjectApiKeyDisplayChars],
+		KeyHash:     HashProjectApiKey(plainKey),
+		CreatedByID: creator.ID,
+	}
+
+	_, err = govalidator.ValidateStruct(apiKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, plainKey, nil
+}
+
+func HashProjectApiKey(plainKey string) string {
+	hash := sha256.Sum256([]byte(plainKey))
+	return hex.EncodeToString(hash[:])
+}
+
+func (apiKey *ProjectApiKey) IsActive(now time.Time) bool {
+	return apiKey.RevokedAt == nil && (apiKey.ExpiresAt == nil || now.Before(*apiKey.ExpiresAt))
+}
+
+func (apiKey *ProjectApiKey) Revoke() error {
+	if apiKey.RevokedAt != nil {
+		return errors.New("[project api key] Api key already revoked")
+	}
+
+	now := time.Now()
+	apiKey.RevokedAt = &now
+	return nil
+}
+
+// Rotate creates a new api key with the same name and scope, keeping the current one valid during the grace period
+func (apiKey *ProjectApiKey) Rotate(gracePeriod time.Duration, creator *User) (*ProjectApiKey, string, error) {
+	now := time.Now()
+	if !apiKey.IsActive(now) {
+		return nil, "", errors.New("[project api key] Only active api keys can be rotated")
+	}
+
+	if gracePeriod < 0 {
+		return nil, "", errors.New("[project api key] Grace period should not be negative")
+	}
+
+	rotatedApiKey, plainKey, err := NewProjectApiKey(apiKey.Project, apiKey.Name, apiKey.Scope, creator)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rotatedApiKey.AllowUnsigned = apiKey.AllowUnsigned
+
+	expiresAt := now.Add(gracePeriod)
+	if apiKey.ExpiresAt == nil || expiresAt.Before(*apiKey.ExpiresAt) {
+		apiKey.ExpiresAt = &expiresAt
+	}
+	return rotatedApiKey, plainKey, nil
+}
+
+func (apiKey *ProjectApiKey) AllowUnsignedIngestion() error {
+	if apiKey.Scope != ProjectApiKeyScopeIngest {
+		return errors.New("[project api key] Only ingest api keys can skip request signing")
+	}
+
+	apiKey.AllowUnsigned = true
+	return nil
+}
+
+// MarkUsed tells whether the last used timestamp changed and should be stored
+func (apiKey *ProjectApiKey) MarkUsed(now time.Time) bool {
+	if apiKey.LastUsedAt != nil && now.Sub(*apiKey.LastUsedAt) < ProjectApiKeyLastUsedAtResolution {
+		return false
+	}
+
+	apiKey.LastUsedAt = &now
+	return true
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProjectApiKey(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should get error when scope is invalid", func(t *testing.T) {
+		_, _, err := NewProjectApiKey(project, "Website", "admin", projectOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project api key] Invalid scope", err.Error())
+	})
+
+	t.Run("should get error when name is empty", func(t *testing.T) {
+		_, _, err := NewProjectApiKey(project, "", ProjectApiKeyScopeIngest, projectOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project api key] Name is required", err.Error())
+	})
+
+	t.Run("should create api key storing only its hash", func(t *testing.T) {
+		apiKey, plainKey, err := NewProjectApiKey(project, "Website", ProjectApiKeyScopeIngest, projectOwner)
+		require.Nil(t, err)
+		require.NotEmpty(t, apiKey.ID)
+		require.Equal(t, project.ID, apiKey.ProjectID)
+		require.Equal(t, "Website", apiKey.Name)
+		require.Equal(t, ProjectApiKeyScopeIngest, apiKey.Scope)
+		require.Equal(t, projectOwner.ID, apiKey.CreatedByID)
+		require.Len(t, plainKey, 52)
+		require.Equal(t, plainKey[:12], apiKey.Prefix)
+		require.Equal(t, HashProjectApiKey(plainKey), apiKey.KeyHash)
+		require.NotEqual(t, plainKey, apiKey.KeyHash)
+		require.True(t, apiKey.IsActive(time.Now()))
+	})
+}
+
+func TestProjectApiKey_Revoke(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+	apiKey, _, _ := NewProjectApiKey(project, "Website", ProjectApiKeyScopeIngest, projectOwner)
+
+	err := apiKey.Revoke()
+	require.Nil(t, err)
+	require.NotNil(t, apiKey.RevokedAt)
+	require.False(t, apiKey.IsActive(time.Now()))
+
+	err = apiKey.Revoke()
+	require.NotNil(t, err)
+	require.Equal(t, "[project api key] Api key already revoked", err.Error())
+}
+
+func TestProjectApiKey_Rotate(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	t.Run("should keep the current api key valid during the grace period", func(t *testing.T) {
+		apiKey, plainKey, _ := NewProjectApiKey(project, "Website", ProjectApiKeyScopeRead, projectOwner)
+
+		rotatedApiKey, rotatedPlainKey, err := apiKey.Rotate(time.Hour, projectOwner)
+		require.Nil(t, err)
+		require.NotEqual(t, apiKey.ID, rotatedApiKey.ID)
+		require.NotEqual(t, plainKey, rotatedPlainKey)
+		require.Equal(t, apiKey.Name, rotatedApiKey.Name)
+		require.Equal(t, apiKey.Scope, rotatedApiKey.Scope)
+		require.Nil(t, rotatedApiKey.ExpiresAt)
+		require.NotNil(t, apiKey.ExpiresAt)
+		require.True(t, apiKey.IsActive(time.Now().Add(30*time.Minute)))
+		require.False(t, apiKey.IsActive(time.Now().Add(2*time.Hour)))
+	})
+
+	t.Run("should get error when api key is revoked", func(t *testing.T) {
+		apiKey, _, _ := NewProjectApiKey(project, "Website", ProjectApiKeyScopeRead, projectOwner)
+		_ = apiKey.Revoke()
+
+		_, _, err := apiKey.Rotate(time.Hour, projectOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project api key] Only active api keys can be rotated", err.Error())
+	})
+}
+
+func TestProjectApiKey_AllowUnsignedIngestion(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+
+	readApiKey, _, _ := NewProjectApiKey(project, "Dashboard", ProjectApiKeyScopeRead, projectOwner)
+	err := readApiKey.AllowUnsignedIngestion()
+	require.NotNil(t, err)
+	require.Equal(t, "[project api key] Only ingest api keys can skip request signing", err.Error())
+
+	ingestApiKey, _, _ := NewProjectApiKey(project, "Website", ProjectApiKeyScopeIngest, projectOwner)
+	err = ingestApiKey.AllowUnsignedIngestion()
+	require.Nil(t, err)
+	require.True(t, ingestApiKey.AllowUnsigned)
+
+	rotatedApiKey, _, _ := ingestApiKey.Rotate(time.Hour, projectOwner)
+	require.True(t, rotatedApiKey.AllowUnsigned)
+}
+
+func TestProjectApiKey_MarkUsed(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("Test", projectOwner)
+	apiKey, _, _ := NewProjectApiKey(project, "Website", ProjectApiKeyScopeIngest, projectOwner)
+
+	now := time.Now()
+	require.True(t, apiKey.MarkUsed(now))
+	require.False(t, apiKey.MarkUsed(now.Add(10*time.Second)))
+	require.True(t, apiKey.MarkUsed(now.Add(ProjectApiKeyLastUsedAtResolution)))
+	require.Equal(t, now.Add(ProjectApiKeyLastUsedAtResolution), *apiKey.LastUsedAt)
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/google/uuid"
@@ -12,6 +14,13 @@ const (
 	ProjectInviteStatusPending  = "pending"
 	ProjectInviteStatusAccepted = "accepted"
 	ProjectInviteStatusDeclined = "declined"
+	ProjectInviteStatusExpired  = "expired"
+
+	ProjectInviteTTL = 7 * 24 * time.Hour
+	// a single reminder is sent once the invite gets this close to its expiry
+	ProjectInviteReminderLeadTime = 48 * time.Hour
+	// invites that don't ask for a role grant the least privileged one
+	ProjectInviteDefaultRole = ProjectRoleViewer
 )
 
 type ProjectInvite struct {
@@ -19,13 +28,23 @@ type ProjectInvite struct {
 	ID        string   `json:"id" gorm:"primaryKey" valid:"uuid~[project invite] Invalid ID"`
 	ProjectID string   `gorm:"column:project_id;type:varchar(255);not null" valid:"-"`
 	Project   *Project `json:"project" gorm:"" valid:"-"`
-	UserID    string   `gorm:"column:user_id;type:varchar(255);not null" valid:"-"`
+	UserID    *string  `gorm:"column:user_id;type:varchar(255);default:null" valid:"-"`
 	User      *User    `json:"user" valid:"-"`
-	Status    string   `json:"status" gorm:"type:varchar(100);not null" valid:"in(pending|accepted|declined|revoked)~[project invite] Invalid status"`
+	Status    string   `json:"status" gorm:"type:varchar(100);not null" valid:"in(pending|accepted|declined|revoked|expired)~[project invite] Invalid status"`
 	Token     *string  `gorm:"type:varchar(255);unique;not null" valid:"uuid~[project invite] Invalid token"`
+	// Role is granted to the user when the invite is accepted. The column default only covers invites sent before
+	// roles existed, which made editors, new invites without a role get ProjectInviteDefaultRole
+	Role string `json:"role" gorm:"type:varchar(50);not null;default:'editor'" valid:"required~[project invite] Role is required,in(admin|editor|viewer)~[project invite] Invalid role"`
+	// InvitedByID is empty for invites issued before the inviter was recorded
+	InvitedByID *string `json:"invited_by_id" gorm:"column:invited_by_id;type:varchar(255);default:null;index" valid:"-"`
+	// ExpiresAt is only empty while the migration fills it for invites created before expiry existed
+	ExpiresAt      *time.Time `json:"expires_at" gorm:"default:null;index" valid:"-"`
+	ReminderSentAt *time.Time `json:"reminder_sent_at" gorm:"default:null" valid:"-"`
+	// Email is where the invite was sent, the user is only known once someone registers with it
+	Email string `json:"email" gorm:"type:varchar(255);not null;default:'';index" valid:"required~[project invite] Email is required,email~[project invite] Invalid email"`
 }
 
-func NewProjectInvite(project *Project, user *User) (*ProjectInvite, error) {
+func NewProjectInvite(project *Project, user *User, role string, invitedBy *User) (*ProjectInvite, error) {
 	_, err := govalidator.ValidateStruct(project)
 	if err != nil {
 		return nil, err
@@ -40,17 +59,26 @@ func NewProjectInvite(project *Project, user *User) (*ProjectInvite, error) {
 		return nil, errors.New("user is already a member of the project")
 	}
 
-	token := uuid.New().String()
-	projectInvite := &ProjectInvite{
-		ID:        uuid.New().String(),
-		ProjectID: project.ID,
-		Project:   project,
-		UserID:    user.ID,
-		User:      user,
-		Status:    ProjectInviteStatusPending,
-		Token:     &token,
+	projectInvite := newProjectInvite(project, *user.Email, role, invitedBy)
+	projectInvite.UserID = &user.ID
+	projectInvite.User = user
+
+	_, err = govalidator.ValidateStruct(projectInvite)
+	if err != nil {
+		return nil, err
 	}
 
+	return projectInvite, nil
+}
+
+// NewProjectInviteByEmail invites someone without an account, the invite is attached to them once they register
+func NewProjectInviteByEmail(project *Project, email string, role string, invitedBy *User) (*ProjectInvite, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, err
+	}
+
+	projectInvite := newProjectInvite(project, NormalizeProjectInviteEmail(email), role, invitedBy)
 	_, err = govalidator.ValidateStruct(projectInvite)
 	if err != nil {
 		return nil, err
@@ -59,7 +87,54 @@ func NewProjectInvite(project *Project, user *User) (*ProjectInvite, error) {
 	return projectInvite, nil
 }
 
+func newProjectInvite(project *Project, email string, role string, invitedBy *User) *ProjectInvite {
+	token := uuid.New().String()
+	expiresAt := time.Now().Add(ProjectInviteTTL)
+	return &ProjectInvite{
+		ID:          uuid.New().String(),
+		ProjectID:   project.ID,
+		Project:     project,
+		Email:       email,
+		Status:      ProjectInviteStatusPending,
+		Token:       &token,
+		Role:        role,
+		InvitedByID: &invitedBy.ID,
+		ExpiresAt:   &expiresAt,
+	}
+}
+
+func NormalizeProjectInviteEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// AttachUser hands an invite sent by email to the verified user registered with that email
+func (projectInvite *ProjectInvite) AttachUser(user *User) error {
+	if projectInvite.UserID != nil {
+		return errors.New("invite already belongs to a user")
+	}
+
+	if projectInvite.Status != ProjectInviteStatusPending {
+		return errors.New("invite already answered or revoked")
+	}
+
+	if !user.IsVerified {
+		return errors.New("user must be verified")
+	}
+
+	if !strings.EqualFold(projectInvite.Email, *user.Email) {
+		return errors.New("invite was sent to another email")
+	}
+
+	projectInvite.UserID = &user.ID
+	projectInvite.User = user
+	return nil
+}
+
 func (projectInvite *ProjectInvite) Accept(token string) error {
+	if projectInvite.UserID == nil {
+		return errors.New("invite can only be accepted after registering with the invited email")
+	}
+
 	err := projectInvite.answer(ProjectInviteStatusAccepted, token)
 	return err
 }
@@ -78,6 +153,10 @@ func (projectInvite *ProjectInvite) answer(answer string, token string) error {
 		return errors.New("invite already answered or revoked")
 	}
 
+	if projectInvite.IsExpired(time.Now()) {
+		return errors.New("invite expired")
+	}
+
 	isValidAnswer := govalidator.IsIn(answer, ProjectInviteStatusAccepted, ProjectInviteStatusDeclined)
 	if !isValidAnswer {
 		return errors.New("invalid answer provided to invite")
@@ -94,3 +173,33 @@ func (projectInvite *ProjectInvite) CanRevoke() (bool, string) {
 	}
 	return true, ""
 }
+
+func (projectInvite *ProjectInvite) IsExpired(now time.Time) bool {
+	return projectInvite.ExpiresAt != nil && !now.Before(*projectInvite.ExpiresAt)
+}
+
+// Renew replaces the token and restarts the expiry, so links sent before stop working
+func (projectInvite *ProjectInvite) Renew(now time.Time) error {
+	if projectInvite.Status != ProjectInviteStatusPending && projectInvite.Status != ProjectInviteStatusExpired {
+		return errors.New("invite already answered or revoked")
+	}
+
+	token := uuid.New().String()
+	expiresAt := now.Add(ProjectInviteTTL)
+	projectInvite.Token = &token
+	projectInvite.Status = ProjectInviteStatusPending
+	projectInvite.ExpiresAt = &expiresAt
+	projectInvite.ReminderSentAt = nil
+	return nil
+}
+
+func (projectInvite *ProjectInvite) NeedsReminder(now time.Time) bool {
+	if projectInvite.Status != ProjectInviteStatusPending || projectInvite.ReminderSentAt != nil {
+		return false
+	}
+
+	if projectInvite.ExpiresAt == nil || projectInvite.IsExpired(now) {
+		return false
+	}
+	return !now.Before(projectInvite.ExpiresAt.Add(-ProjectInviteReminderLeadTime))
+}
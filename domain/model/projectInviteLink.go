@@ -0,0 +1,146 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ProjectInviteLinkTTL    = 7 * 24 * time.Hour
+	ProjectInviteLinkMaxTTL = 30 * 24 * time.Hour
+)
+
+// ProjectInviteLink is a reusable join link, unlike project invites it isn't addressed to anyone
+type ProjectInviteLink struct {
+	gorm.Model
+	ID          string   `json:"id" gorm:"primaryKey" valid:"uuid~[project invite link] Invalid ID"`
+	ProjectID   string   `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;index" valid:"required~[project invite link] Project is required"`
+	Project     *Project `json:"project" valid:"-"`
+	CreatedByID string   `json:"created_by_id" gorm:"column:created_by_id;type:varchar(255);not null" valid:"required~[project invite link] Creator is required"`
+	Token       *string  `json:"-" gorm:"type:varchar(255);unique;not null" valid:"uuid~[project invite link] Invalid token"`
+	Role        string   `json:"role" gorm:"type:varchar(50);not null" valid:"required~[project invite link] Role is required,in(admin|editor|viewer)~[project invite link] Invalid role"`
+	// MaxUses is empty when the link can be used until it expires
+	MaxUses   *int       `json:"max_uses" gorm:"default:null" valid:"-"`
+	UsesCount int        `json:"uses_count" gorm:"not null;default:0" valid:"-"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"not null" valid:"-"`
+	RevokedAt *time.Time `json:"revoked_at" gorm:"default:null" valid:"-"`
+}
+
+// ProjectInviteLinkJoin records who joined a project through an invite link
+type ProjectInviteLinkJoin struct {
+	ID                  string    `json:"id" gorm:"primaryKey" valid:"uuid~[project invite link join] Invalid ID"`
+	ProjectInviteLinkID string    `json:"project_invite_link_id" gorm:"column:project_invite_link_id;type:varchar(255);not null;index" valid:"required~[project invite link join] Invite link is required"`
+	ProjectID           string    `json:"project_id" gorm:"column:project_id;type:varchar(255);not null" valid:"required~[project invite link join] Project is required"`
+	UserID              string    `json:"user_id" gorm:"column:user_id;type:varchar(255);not null;index" valid:"required~[project invite link join] User is required"`
+	Role                string    `json:"role" gorm:"type:varchar(50);not null" valid:"required~[project invite link join] Role is required"`
+	CreatedAt           time.Time `json:"created_at" valid:"-"`
+}
+
+func NewProjectInviteLink(
+	project *Project,
+	creator *ProjectMember,
+	role string,
+	maxUses *int,
+	expiresAt time.Time,
+) (*ProjectInviteLink, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if creator.ProjectID != project.ID {
+		return nil, errors.New("[project invite link] Creator must be a project member")
+	}
+
+	if maxUses != nil && *maxUses < 1 {
+		return nil, errors.New("[project invite link] Max uses should be at least 1")
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, errors.New("[project invite link] Expiry should be in the future")
+	}
+
+	if expiresAt.After(now.Add(ProjectInviteLinkMaxTTL)) {
+		return nil, errors.New("[project invite link] Expiry should be within 30 days")
+	}
+
+	token := uuid.New().String()
+	link := &ProjectInviteLink{
+		ID:          uuid.New().String(),
+		ProjectID:   project.ID,
+		Project:     project,
+		CreatedByID: creator.UserID,
+		Token:       &token,
+		Role:        role,
+		MaxUses:     maxUses,
+		ExpiresAt:   expiresAt,
+	}
+
+	_, err = govalidator.ValidateStruct(link)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (link *ProjectInviteLink) IsActive(now time.Time) bool {
+	return link.RevokedAt == nil && now.Before(link.ExpiresAt) && !link.reachedMaxUses()
+}
+
+func (link *ProjectInviteLink) reachedMaxUses() bool {
+	return link.MaxUses != nil && link.UsesCount >= *link.MaxUses
+}
+
+// Join adds the user to the project with the link role and counts the use
+func (link *ProjectInviteLink) Join(project *Project, user *User, now time.Time) (*ProjectInviteLinkJoin, error) {
+	if project.ID != link.ProjectID {
+		return nil, errors.New("invite link belongs to another project")
+	}
+
+	if link.RevokedAt != nil {
+		return nil, errors.New("invite link revoked")
+	}
+
+	if !now.Before(link.ExpiresAt) {
+		return nil, errors.New("invite link expired")
+	}
+
+	if link.reachedMaxUses() {
+		return nil, errors.New("invite link reached its max uses")
+	}
+
+	if !user.IsVerified {
+		return nil, errors.New("user must be verified")
+	}
+
+	err := project.AddMember(user, link.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	link.UsesCount++
+	return &ProjectInviteLinkJoin{
+		ID:                  uuid.New().String(),
+		ProjectInviteLinkID: link.ID,
+		ProjectID:           project.ID,
+		UserID:              user.ID,
+		Role:                link.Role,
+		CreatedAt:           now,
+	}, nil
+}
+
+func (link *ProjectInviteLink) Revoke() error {
+	if link.RevokedAt != nil {
+		return errors.New("[project invite link] Invite link already revoked")
+	}
+
+	now := time.Now()
+	link.RevokedAt = &now
+	return nil
+}
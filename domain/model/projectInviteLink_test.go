@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newProjectForInviteLink() (*Project, *ProjectMember) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("test", projectOwner)
+	return project, project.Memberships[0]
+}
+
+func TestNewProjectInviteLink(t *testing.T) {
+	t.Run("should get error when max uses is not positive", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		maxUses := 0
+		_, err := NewProjectInviteLink(project, owner, ProjectRoleEditor, &maxUses, time.Now().Add(time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite link] Max uses should be at least 1", err.Error())
+	})
+
+	t.Run("should get error when expiry is not within the allowed range", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		_, err := NewProjectInviteLink(project, owner, ProjectRoleEditor, nil, time.Now().Add(-time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite link] Expiry should be in the future", err.Error())
+
+		_, err = NewProjectInviteLink(project, owner, ProjectRoleEditor, nil, time.Now().Add(ProjectInviteLinkMaxTTL+time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite link] Expiry should be within 30 days", err.Error())
+	})
+
+	t.Run("should get error when role can't be granted through a link", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		_, err := NewProjectInviteLink(project, owner, ProjectRoleOwner, nil, time.Now().Add(time.Hour))
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite link] Invalid role", err.Error())
+	})
+
+	t.Run("should return active invite link", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		maxUses := 5
+		link, err := NewProjectInviteLink(project, owner, ProjectRoleViewer, &maxUses, time.Now().Add(time.Hour))
+		require.Nil(t, err)
+		require.Equal(t, owner.UserID, link.CreatedByID)
+		require.NotEmpty(t, *link.Token)
+		require.Equal(t, 0, link.UsesCount)
+		require.True(t, link.IsActive(time.Now()))
+	})
+}
+
+func TestProjectInviteLinkJoin(t *testing.T) {
+	newVerifiedUser := func(email string) *User {
+		user, _ := NewUser(email, "Member", "pass4321")
+		_ = user.Verify(*user.VerificationToken)
+		return user
+	}
+
+	t.Run("should get error when link is revoked or expired", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		link, _ := NewProjectInviteLink(project, owner, ProjectRoleViewer, nil, time.Now().Add(time.Hour))
+
+		_, err := link.Join(project, newVerifiedUser("member@example.com"), link.ExpiresAt)
+		require.NotNil(t, err)
+		require.Equal(t, "invite link expired", err.Error())
+
+		_ = link.Revoke()
+		_, err = link.Join(project, newVerifiedUser("member@example.com"), time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "invite link revoked", err.Error())
+	})
+
+	t.Run("should get error when user is not verified", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		link, _ := NewProjectInviteLink(project, owner, ProjectRoleViewer, nil, time.Now().Add(time.Hour))
+		user, _ := NewUser("member@example.com", "Member", "pass4321")
+
+		_, err := link.Join(project, user, time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "user must be verified", err.Error())
+	})
+
+	t.Run("should get error when user is already a member", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		link, _ := NewProjectInviteLink(project, owner, ProjectRoleViewer, nil, time.Now().Add(time.Hour))
+
+		_, err := link.Join(project, project.Memberships[0].User, time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "user is already a member of the project", err.Error())
+		require.Equal(t, 0, link.UsesCount)
+	})
+
+	t.Run("should add members until the max uses is reached", func(t *testing.T) {
+		project, owner := newProjectForInviteLink()
+		maxUses := 1
+		link, _ := NewProjectInviteLink(project, owner, ProjectRoleEditor, &maxUses, time.Now().Add(time.Hour))
+		user := newVerifiedUser("member@example.com")
+
+		join, err := link.Join(project, user, time.Now())
+		require.Nil(t, err)
+		require.Equal(t, link.ID, join.ProjectInviteLinkID)
+		require.Equal(t, user.ID, join.UserID)
+		require.Equal(t, ProjectRoleEditor, join.Role)
+		require.Equal(t, 1, link.UsesCount)
+		require.True(t, project.HasMember(user))
+		require.False(t, link.IsActive(time.Now()))
+
+		_, err = link.Join(project, newVerifiedUser("other@example.com"), time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "invite link reached its max uses", err.Error())
+	})
+}
+
+func TestProjectInviteLinkRevoke(t *testing.T) {
+	project, owner := newProjectForInviteLink()
+	link, _ := NewProjectInviteLink(project, owner, ProjectRoleViewer, nil, time.Now().Add(time.Hour))
+
+	err := link.Revoke()
+	require.Nil(t, err)
+	require.NotNil(t, link.RevokedAt)
+
+	err = link.Revoke()
+	require.NotNil(t, err)
+	require.Equal(t, "[project invite link] Invite link already revoked", err.Error())
+}
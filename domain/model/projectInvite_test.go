@@ -2,20 +2,21 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewProjectInvite(t *testing.T) {
 	t.Run("should get error when provided project is invalid", func(t *testing.T) {
-		_, err := NewProjectInvite(&Project{}, &User{})
+		_, err := NewProjectInvite(&Project{}, &User{}, ProjectRoleEditor, &User{})
 		require.NotNil(t, err)
 	})
 
 	t.Run("should get error when provided user is invalid", func(t *testing.T) {
 		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
 		project, _ := NewProject("test", projectOwner)
-		_, err := NewProjectInvite(project, &User{})
+		_, err := NewProjectInvite(project, &User{}, ProjectRoleEditor, projectOwner)
 		require.NotNil(t, err)
 	})
 
@@ -25,28 +26,125 @@ func TestNewProjectInvite(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, err := NewProjectInvite(project, userToInvite)
+		invite, err := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 		require.Nil(t, err)
 
 		_ = invite.Accept(*invite.Token)
-		project.Members = append(project.Members, userToInvite)
+		_ = project.AddMember(userToInvite, invite.Role)
 
-		_, err = NewProjectInvite(project, userToInvite)
+		_, err = NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 		require.NotNil(t, err)
 		require.Equal(t, "user is already a member of the project", err.Error())
 	})
 
+	t.Run("should get error when role can't be granted through an invite", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+
+		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+		_, err := NewProjectInvite(project, userToInvite, ProjectRoleOwner, projectOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite] Invalid role", err.Error())
+	})
+
 	t.Run("should return project invite when provided project and user are valid", func(t *testing.T) {
 		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
 		_ = projectOwner.Verify(*projectOwner.VerificationToken)
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, err := NewProjectInvite(project, userToInvite)
+		invite, err := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 		require.Nil(t, err)
 		require.Equal(t, ProjectInviteStatusPending, invite.Status)
+		require.Equal(t, ProjectRoleEditor, invite.Role)
+		require.Equal(t, projectOwner.ID, *invite.InvitedByID)
 		require.NotNil(t, invite.Token)
 		require.NotEmpty(t, *invite.Token)
+		require.NotNil(t, invite.ExpiresAt)
+		require.WithinDuration(t, time.Now().Add(ProjectInviteTTL), *invite.ExpiresAt, time.Minute)
+		require.Equal(t, userToInvite.ID, *invite.UserID)
+		require.Equal(t, "member@example.com", invite.Email)
+	})
+}
+
+func TestNewProjectInviteByEmail(t *testing.T) {
+	t.Run("should get error when provided email is invalid", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+		_, err := NewProjectInviteByEmail(project, "not-an-email", ProjectRoleEditor, projectOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project invite] Invalid email", err.Error())
+	})
+
+	t.Run("should return project invite without user", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+		invite, err := NewProjectInviteByEmail(project, " New.Member@Example.com", ProjectRoleViewer, projectOwner)
+		require.Nil(t, err)
+		require.Equal(t, "new.member@example.com", invite.Email)
+		require.Nil(t, invite.UserID)
+		require.Nil(t, invite.User)
+		require.Equal(t, ProjectInviteStatusPending, invite.Status)
+	})
+}
+
+func TestAttachUser(t *testing.T) {
+	newInvite := func() *ProjectInvite {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+		invite, _ := NewProjectInviteByEmail(project, "member@example.com", ProjectRoleEditor, projectOwner)
+		return invite
+	}
+	newVerifiedUser := func(email string) *User {
+		user, _ := NewUser(email, "Member", "pass4321")
+		_ = user.Verify(*user.VerificationToken)
+		return user
+	}
+
+	t.Run("should get error when user is not verified", func(t *testing.T) {
+		user, _ := NewUser("member@example.com", "Member", "pass4321")
+		err := newInvite().AttachUser(user)
+		require.NotNil(t, err)
+		require.Equal(t, "user must be verified", err.Error())
+	})
+
+	t.Run("should get error when invite was sent to another email", func(t *testing.T) {
+		err := newInvite().AttachUser(newVerifiedUser("other@example.com"))
+		require.NotNil(t, err)
+		require.Equal(t, "invite was sent to another email", err.Error())
+	})
+
+	t.Run("should get error when invite is not pending", func(t *testing.T) {
+		invite := newInvite()
+		invite.Status = ProjectInviteStatusDeclined
+		err := invite.AttachUser(newVerifiedUser("member@example.com"))
+		require.NotNil(t, err)
+		require.Equal(t, "invite already answered or revoked", err.Error())
+	})
+
+	t.Run("should attach user and allow accepting the invite", func(t *testing.T) {
+		invite := newInvite()
+		user := newVerifiedUser("Member@Example.com")
+
+		err := invite.Accept(*invite.Token)
+		require.NotNil(t, err)
+		require.Equal(t, "invite can only be accepted after registering with the invited email", err.Error())
+
+		err = invite.AttachUser(user)
+		require.Nil(t, err)
+		require.Equal(t, user.ID, *invite.UserID)
+
+		err = invite.AttachUser(user)
+		require.NotNil(t, err)
+		require.Equal(t, "invite already belongs to a user", err.Error())
+
+		err = invite.Accept(*invite.Token)
+		require.Nil(t, err)
+		require.Equal(t, ProjectInviteStatusAccepted, invite.Status)
 	})
 }
 
@@ -57,7 +155,7 @@ func TestAccept(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		err := invite.Accept("invalid-token")
 		require.NotNil(t, err)
@@ -70,7 +168,7 @@ func TestAccept(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 		invite.Status = ProjectInviteStatusAccepted
 
 		err := invite.Accept(*invite.Token)
@@ -78,13 +176,29 @@ func TestAccept(t *testing.T) {
 		require.Equal(t, "invite already answered or revoked", err.Error())
 	})
 
+	t.Run("should get error when invite is expired", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+
+		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		expiredAt := time.Now().Add(-time.Minute)
+		invite.ExpiresAt = &expiredAt
+
+		err := invite.Accept(*invite.Token)
+		require.NotNil(t, err)
+		require.Equal(t, "invite expired", err.Error())
+		require.Equal(t, ProjectInviteStatusPending, invite.Status)
+	})
+
 	t.Run("should accept invite", func(t *testing.T) {
 		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
 		_ = projectOwner.Verify(*projectOwner.VerificationToken)
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		err := invite.Accept(*invite.Token)
 		require.Nil(t, err)
@@ -99,7 +213,7 @@ func TestDecline(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		err := invite.Decline("invalid-token")
 		require.NotNil(t, err)
@@ -112,7 +226,7 @@ func TestDecline(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 		invite.Status = ProjectInviteStatusAccepted
 
 		err := invite.Decline(*invite.Token)
@@ -120,13 +234,29 @@ func TestDecline(t *testing.T) {
 		require.Equal(t, "invite already answered or revoked", err.Error())
 	})
 
+	t.Run("should get error when invite is expired", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+
+		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		expiredAt := time.Now().Add(-time.Minute)
+		invite.ExpiresAt = &expiredAt
+
+		err := invite.Decline(*invite.Token)
+		require.NotNil(t, err)
+		require.Equal(t, "invite expired", err.Error())
+		require.Equal(t, ProjectInviteStatusPending, invite.Status)
+	})
+
 	t.Run("should decline invite", func(t *testing.T) {
 		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
 		_ = projectOwner.Verify(*projectOwner.VerificationToken)
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		err := invite.Decline(*invite.Token)
 		require.Nil(t, err)
@@ -141,7 +271,7 @@ func TestCanRevoke(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		invite.Status = ProjectInviteStatusAccepted
 		canRevoke, reason := invite.CanRevoke()
@@ -160,7 +290,7 @@ func TestCanRevoke(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		canRevoke, reason := invite.CanRevoke()
 		require.True(t, canRevoke)
@@ -175,10 +305,77 @@ func TestAnswer(t *testing.T) {
 		project, _ := NewProject("test", projectOwner)
 
 		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
-		invite, _ := NewProjectInvite(project, userToInvite)
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
 
 		err := invite.answer("invalid-answer", *invite.Token)
 		require.NotNil(t, err)
 		require.Equal(t, "invalid answer provided to invite", err.Error())
 	})
 }
+
+func TestRenew(t *testing.T) {
+	t.Run("should get error when invite was answered", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+
+		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		invite.Status = ProjectInviteStatusDeclined
+
+		err := invite.Renew(time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "invite already answered or revoked", err.Error())
+	})
+
+	t.Run("should renew expired invite with a new token", func(t *testing.T) {
+		projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+		_ = projectOwner.Verify(*projectOwner.VerificationToken)
+		project, _ := NewProject("test", projectOwner)
+
+		userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		previousToken := *invite.Token
+		reminderSentAt := time.Now()
+		invite.ReminderSentAt = &reminderSentAt
+		invite.Status = ProjectInviteStatusExpired
+
+		now := time.Now().Add(ProjectInviteTTL)
+		err := invite.Renew(now)
+		require.Nil(t, err)
+		require.Equal(t, ProjectInviteStatusPending, invite.Status)
+		require.NotEqual(t, previousToken, *invite.Token)
+		require.Equal(t, now.Add(ProjectInviteTTL), *invite.ExpiresAt)
+		require.Nil(t, invite.ReminderSentAt)
+		require.NotNil(t, invite.Accept(previousToken))
+	})
+}
+
+func TestNeedsReminder(t *testing.T) {
+	projectOwner, _ := NewUser("owner@example.com", "Owner", "pass1234")
+	_ = projectOwner.Verify(*projectOwner.VerificationToken)
+	project, _ := NewProject("test", projectOwner)
+	userToInvite, _ := NewUser("member@example.com", "Member", "pass4321")
+
+	t.Run("should not need reminder before the lead time", func(t *testing.T) {
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		require.False(t, invite.NeedsReminder(time.Now()))
+	})
+
+	t.Run("should need reminder within the lead time", func(t *testing.T) {
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		now := invite.ExpiresAt.Add(-ProjectInviteReminderLeadTime)
+		require.True(t, invite.NeedsReminder(now))
+
+		invite.ReminderSentAt = &now
+		require.False(t, invite.NeedsReminder(now))
+	})
+
+	t.Run("should not need reminder once expired or answered", func(t *testing.T) {
+		invite, _ := NewProjectInvite(project, userToInvite, ProjectRoleEditor, projectOwner)
+		require.False(t, invite.NeedsReminder(*invite.ExpiresAt))
+
+		invite.Status = ProjectInviteStatusAccepted
+		require.False(t, invite.NeedsReminder(invite.ExpiresAt.Add(-time.Hour)))
+	})
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+)
+
+const (
+	ProjectRoleOwner  = "owner"
+	ProjectRoleAdmin  = "admin"
+	ProjectRoleEditor = "editor"
+	ProjectRoleViewer = "viewer"
+)
+
+// ProjectMember maps the user_projects join table, which holds the role of each project member
+type ProjectMember struct {
+	ProjectID string    `json:"project_id" gorm:"column:project_id;primaryKey" valid:"required~[project member] Project is required"`
+	UserID    string    `json:"user_id" gorm:"column:user_id;primaryKey" valid:"required~[project member] User is required"`
+	Role      string    `json:"role" gorm:"type:varchar(50);not null;default:'editor'" valid:"required~[project member] Role is required,in(owner|admin|editor|viewer)~[project member] Invalid role"`
+	CreatedAt time.Time `json:"created_at" valid:"-"`
+	User      *User     `json:"user,omitempty" gorm:"foreignKey:UserID" valid:"-"`
+}
+
+func (ProjectMember) TableName() string {
+	return "user_projects"
+}
+
+func NewProjectMember(project *Project, user *User, role string) (*ProjectMember, error) {
+	member := &ProjectMember{
+		ProjectID: project.ID,
+		UserID:    user.ID,
+		Role:      role,
+		User:      user,
+	}
+
+	_, err := govalidator.ValidateStruct(member)
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// ChangeRole never touches the owner role, which only moves through an ownership transfer
+func (member *ProjectMember) ChangeRole(role string) error {
+	if member.Role == ProjectRoleOwner {
+		return errors.New("[project member] Owner role can't be changed")
+	}
+
+	if role == ProjectRoleOwner {
+		return errors.New("[project member] Owner role can't be assigned")
+	}
+
+	previousRole := member.Role
+	member.Role = role
+	_, err := govalidator.ValidateStruct(member)
+	if err != nil {
+		member.Role = previousRole
+	}
+	return err
+}
+
+// CanAssignRole tells whether the member can grant the role to someone else, either by inviting or by changing roles
+func (member *ProjectMember) CanAssignRole(role string) bool {
+	switch member.Role {
+	case ProjectRoleOwner:
+		return role == ProjectRoleAdmin || role == ProjectRoleEditor || role == ProjectRoleViewer
+	case ProjectRoleAdmin:
+		return role == ProjectRoleEditor || role == ProjectRoleViewer
+	default:
+		return false
+	}
+}
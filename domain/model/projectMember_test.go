@@ -0,0 +1,82 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProjectMember(t *testing.T) {
+	owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+	owner.Verify(*owner.VerificationToken)
+	project, _ := NewProject("my project", owner)
+	user, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
+
+	t.Run("should get error when role is invalid", func(t *testing.T) {
+		_, err := NewProjectMember(project, user, "guest")
+		require.NotNil(t, err)
+		require.Equal(t, "[project member] Invalid role", err.Error())
+	})
+
+	t.Run("should get project member", func(t *testing.T) {
+		member, err := NewProjectMember(project, user, ProjectRoleViewer)
+		require.Nil(t, err)
+		require.Equal(t, project.ID, member.ProjectID)
+		require.Equal(t, user.ID, member.UserID)
+		require.Equal(t, ProjectRoleViewer, member.Role)
+	})
+
+	t.Run("should make the project owner a member with the owner role", func(t *testing.T) {
+		membership := project.FindMembership(owner.ID)
+		require.NotNil(t, membership)
+		require.Equal(t, ProjectRoleOwner, membership.Role)
+	})
+}
+
+func TestProjectMember_ChangeRole(t *testing.T) {
+	t.Run("should get error when changing the owner role", func(t *testing.T) {
+		member := &ProjectMember{ProjectID: "project-id", UserID: "user-id", Role: ProjectRoleOwner}
+		err := member.ChangeRole(ProjectRoleAdmin)
+		require.NotNil(t, err)
+		require.Equal(t, "[project member] Owner role can't be changed", err.Error())
+		require.Equal(t, ProjectRoleOwner, member.Role)
+	})
+
+	t.Run("should get error when assigning the owner role", func(t *testing.T) {
+		member := &ProjectMember{ProjectID: "project-id", UserID: "user-id", Role: ProjectRoleAdmin}
+		err := member.ChangeRole(ProjectRoleOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project member] Owner role can't be assigned", err.Error())
+		require.Equal(t, ProjectRoleAdmin, member.Role)
+	})
+
+	t.Run("should keep the previous role when the new one is invalid", func(t *testing.T) {
+		member := &ProjectMember{ProjectID: "project-id", UserID: "user-id", Role: ProjectRoleEditor}
+		err := member.ChangeRole("guest")
+		require.NotNil(t, err)
+		require.Equal(t, "[project member] Invalid role", err.Error())
+		require.Equal(t, ProjectRoleEditor, member.Role)
+	})
+
+	t.Run("should change role", func(t *testing.T) {
+		member := &ProjectMember{ProjectID: "project-id", UserID: "user-id", Role: ProjectRoleEditor}
+		err := member.ChangeRole(ProjectRoleViewer)
+		require.Nil(t, err)
+		require.Equal(t, ProjectRoleViewer, member.Role)
+	})
+}
+
+func TestProjectMember_CanAssignRole(t *testing.T) {
+	owner := &ProjectMember{Role: ProjectRoleOwner}
+	require.True(t, owner.CanAssignRole(ProjectRoleAdmin))
+	require.True(t, owner.CanAssignRole(ProjectRoleViewer))
+	require.False(t, owner.CanAssignRole(ProjectRoleOwner))
+
+	admin := &ProjectMember{Role: ProjectRoleAdmin}
+	require.True(t, admin.CanAssignRole(ProjectRoleEditor))
+	require.True(t, admin.CanAssignRole(ProjectRoleViewer))
+	require.False(t, admin.CanAssignRole(ProjectRoleAdmin))
+
+	editor := &ProjectMember{Role: ProjectRoleEditor}
+	require.False(t, editor.CanAssignRole(ProjectRoleViewer))
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ProjectOwnershipTransferStatusPending   = "pending"
+	ProjectOwnershipTransferStatusConfirmed = "confirmed"
+	ProjectOwnershipTransferStatusCancelled = "cancelled"
+
+	ProjectOwnershipTransferTTL = 7 * 24 * time.Hour
+)
+
+// ProjectOwnershipTransfer is kept after being answered, so the project keeps a history of its owners
+type ProjectOwnershipTransfer struct {
+	gorm.Model
+	ID          string     `json:"id" gorm:"primaryKey" valid:"uuid~[project ownership transfer] Invalid ID"`
+	ProjectID   string     `json:"project_id" gorm:"column:project_id;type:varchar(255);not null;index" valid:"required~[project ownership transfer] Project is required"`
+	Project     *Project   `json:"project" valid:"-"`
+	FromUserID  string     `json:"from_user_id" gorm:"column:from_user_id;type:varchar(255);not null" valid:"required~[project ownership transfer] Current owner is required"`
+	FromUser    *User      `json:"from_user" valid:"-"`
+	ToUserID    string     `json:"to_user_id" gorm:"column:to_user_id;type:varchar(255);not null" valid:"required~[project ownership transfer] Nominee is required"`
+	ToUser      *User      `json:"to_user" valid:"-"`
+	Status      string     `json:"status" gorm:"type:varchar(50);not null" valid:"in(pending|confirmed|cancelled)~[project ownership transfer] Invalid status"`
+	Token       *string    `json:"-" gorm:"type:varchar(255);unique;not null" valid:"uuid~[project ownership transfer] Invalid token"`
+	ExpiresAt   time.Time  `json:"expires_at" gorm:"not null" valid:"-"`
+	ConfirmedAt *time.Time `json:"confirmed_at" gorm:"default:null" valid:"-"`
+}
+
+func NewProjectOwnershipTransfer(project *Project, nominee *User) (*ProjectOwnershipTransfer, error) {
+	_, err := govalidator.ValidateStruct(project)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = govalidator.ValidateStruct(nominee)
+	if err != nil {
+		return nil, err
+	}
+
+	if !project.HasMember(nominee) {
+		return nil, errors.New("[project ownership transfer] Nominee must be a project member")
+	}
+
+	if project.OwnerID == nominee.ID {
+		return nil, errors.New("[project ownership transfer] Nominee already owns the project")
+	}
+
+	token := uuid.New().String()
+	transfer := &ProjectOwnershipTransfer{
+		ID:         uuid.New().String(),
+		ProjectID:  project.ID,
+		Project:    project,
+		FromUserID: project.OwnerID,
+		ToUserID:   nominee.ID,
+		ToUser:     nominee,
+		Status:     ProjectOwnershipTransferStatusPending,
+		Token:      &token,
+		ExpiresAt:  time.Now().Add(ProjectOwnershipTransferTTL),
+	}
+
+	_, err = govalidator.ValidateStruct(transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// Confirm hands the project over to the nominee, as long as the project wasn't transferred since the nomination
+func (transfer *ProjectOwnershipTransfer) Confirm(project *Project, token string, now time.Time) error {
+	if *transfer.Token != token {
+		return errors.New("invalid token provided to confirm ownership transfer")
+	}
+
+	if transfer.Status != ProjectOwnershipTransferStatusPending {
+		return errors.New("ownership transfer already confirmed or cancelled")
+	}
+
+	if !now.Before(transfer.ExpiresAt) {
+		return errors.New("ownership transfer expired")
+	}
+
+	if project.ID != transfer.ProjectID || project.OwnerID != transfer.FromUserID {
+		return errors.New("project ownership changed since the transfer was requested")
+	}
+
+	err := project.TransferOwnership(transfer.ToUserID)
+	if err != nil {
+		return err
+	}
+
+	transfer.Status = ProjectOwnershipTransferStatusConfirmed
+	transfer.ConfirmedAt = &now
+	return nil
+}
+
+func (transfer *ProjectOwnershipTransfer) Cancel() error {
+	if transfer.Status != ProjectOwnershipTransferStatusPending {
+		return errors.New("ownership transfer already confirmed or cancelled")
+	}
+
+	transfer.Status = ProjectOwnershipTransferStatusCancelled
+	return nil
+}
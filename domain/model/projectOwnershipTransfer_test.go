@@ -0,0 +1,118 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newProjectWithMember(t *testing.T) (*Project, *User, *User) {
+	owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+	owner.Verify(*owner.VerificationToken)
+	project, _ := NewProject("my project", owner)
+
+	member, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
+	err := project.AddMember(member, ProjectRoleEditor)
+	require.Nil(t, err)
+	return project, owner, member
+}
+
+func TestNewProjectOwnershipTransfer(t *testing.T) {
+	t.Run("should get error when nominee is not a project member", func(t *testing.T) {
+		project, _, _ := newProjectWithMember(t)
+		outsider, _ := NewUser("outsider@test.com", "Outsider", "pass1234")
+
+		_, err := NewProjectOwnershipTransfer(project, outsider)
+		require.NotNil(t, err)
+		require.Equal(t, "[project ownership transfer] Nominee must be a project member", err.Error())
+	})
+
+	t.Run("should get error when nominee already owns the project", func(t *testing.T) {
+		project, owner, _ := newProjectWithMember(t)
+
+		_, err := NewProjectOwnershipTransfer(project, owner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project ownership transfer] Nominee already owns the project", err.Error())
+	})
+
+	t.Run("should get pending transfer", func(t *testing.T) {
+		project, owner, member := newProjectWithMember(t)
+
+		transfer, err := NewProjectOwnershipTransfer(project, member)
+		require.Nil(t, err)
+		require.Equal(t, ProjectOwnershipTransferStatusPending, transfer.Status)
+		require.Equal(t, owner.ID, transfer.FromUserID)
+		require.Equal(t, member.ID, transfer.ToUserID)
+		require.NotNil(t, transfer.Token)
+		require.True(t, transfer.ExpiresAt.After(time.Now()))
+	})
+}
+
+func TestProjectOwnershipTransferConfirm(t *testing.T) {
+	t.Run("should get error when token is invalid", func(t *testing.T) {
+		project, _, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+
+		err := transfer.Confirm(project, "invalid-token", time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "invalid token provided to confirm ownership transfer", err.Error())
+	})
+
+	t.Run("should get error when transfer is expired", func(t *testing.T) {
+		project, _, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+
+		err := transfer.Confirm(project, *transfer.Token, transfer.ExpiresAt)
+		require.NotNil(t, err)
+		require.Equal(t, "ownership transfer expired", err.Error())
+	})
+
+	t.Run("should get error when transfer was cancelled", func(t *testing.T) {
+		project, _, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+		require.Nil(t, transfer.Cancel())
+
+		err := transfer.Confirm(project, *transfer.Token, time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "ownership transfer already confirmed or cancelled", err.Error())
+	})
+
+	t.Run("should get error when project owner changed since the request", func(t *testing.T) {
+		project, _, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+		project.OwnerID = member.ID
+
+		err := transfer.Confirm(project, *transfer.Token, time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "project ownership changed since the transfer was requested", err.Error())
+	})
+
+	t.Run("should get error when nominee left the project", func(t *testing.T) {
+		project, _, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+		require.Nil(t, project.RemoveMember(member.ID))
+
+		err := transfer.Confirm(project, *transfer.Token, time.Now())
+		require.NotNil(t, err)
+		require.Equal(t, "user is not a member of the project", err.Error())
+		require.Equal(t, ProjectOwnershipTransferStatusPending, transfer.Status)
+	})
+
+	t.Run("should transfer project ownership", func(t *testing.T) {
+		project, owner, member := newProjectWithMember(t)
+		transfer, _ := NewProjectOwnershipTransfer(project, member)
+		now := time.Now()
+
+		err := transfer.Confirm(project, *transfer.Token, now)
+		require.Nil(t, err)
+		require.Equal(t, ProjectOwnershipTransferStatusConfirmed, transfer.Status)
+		require.Equal(t, now, *transfer.ConfirmedAt)
+		require.Equal(t, member.ID, project.OwnerID)
+		require.Equal(t, ProjectRoleOwner, project.FindMembership(member.ID).Role)
+		require.Equal(t, ProjectRoleAdmin, project.FindMembership(owner.ID).Role)
+
+		err = transfer.Cancel()
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// ProjectUsage counts the events tracked by a project in a month, to enforce its monthly event quota
+type ProjectUsage struct {
+	ProjectID   string    `json:"project_id" gorm:"column:project_id;type:varchar(255);primaryKey"`
+	PeriodStart time.Time `json:"period_start" gorm:"column:period_start;type:timestamp with time zone;primaryKey"`
+	EventCount  int       `json:"event_count" gorm:"not null;default:0"`
+}
+
+// UsagePeriodStart returns the start of the month containing t, in UTC
+func UsagePeriodStart(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func UsagePeriodEnd(t time.Time) time.Time {
+	return UsagePeriodStart(t).AddDate(0, 1, 0)
+}
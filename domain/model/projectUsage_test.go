@@ -0,0 +1,16 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsagePeriod(t *testing.T) {
+	location, _ := time.LoadLocation("America/Sao_Paulo")
+	at := time.Date(2024, 2, 29, 22, 30, 0, 0, location)
+
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), UsagePeriodStart(at))
+	require.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), UsagePeriodEnd(at))
+}
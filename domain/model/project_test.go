@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,8 +41,6 @@ func TestNewProject(t *testing.T) {
 		project, err := NewProject("my project", owner)
 		require.Nil(t, err)
 		require.NotNil(t, project)
-		require.NotNil(t, project.Token)
-		require.NotEmpty(t, *project.Token)
 	})
 }
 
@@ -78,7 +77,7 @@ func TestAddMember(t *testing.T) {
 		project, _ := NewProject("my project", owner)
 
 		newMember, _ := NewUser("", "Jon Doe", "pass1234")
-		err := project.AddMember(newMember)
+		err := project.AddMember(newMember, ProjectRoleEditor)
 		require.NotNil(t, err)
 	})
 
@@ -89,13 +88,26 @@ func TestAddMember(t *testing.T) {
 		project, _ := NewProject("my project", owner)
 
 		newMember, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
-		project.AddMember(newMember)
+		project.AddMember(newMember, ProjectRoleEditor)
 
-		err := project.AddMember(newMember)
+		err := project.AddMember(newMember, ProjectRoleEditor)
 		require.NotNil(t, err)
 		require.Equal(t, "user is already a member of the project", err.Error())
 	})
 
+	t.Run("should get error when adding a member as owner", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		newMember, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
+		err := project.AddMember(newMember, ProjectRoleOwner)
+		require.NotNil(t, err)
+		require.Equal(t, "[project member] Owner role can't be assigned", err.Error())
+		require.Len(t, project.Memberships, 1)
+	})
+
 	t.Run("should add member", func(t *testing.T) {
 		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
 		owner.Verify(*owner.VerificationToken)
@@ -103,8 +115,159 @@ func TestAddMember(t *testing.T) {
 		project, _ := NewProject("my project", owner)
 
 		newMember, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
-		err := project.AddMember(newMember)
+		err := project.AddMember(newMember, ProjectRoleEditor)
+		require.Nil(t, err)
+		require.Len(t, project.Memberships, 2)
+		require.Equal(t, newMember, project.FindMembership(newMember.ID).User)
+		require.Equal(t, ProjectRoleEditor, project.FindMembership(newMember.ID).Role)
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Run("should get error when user is not a member of the project", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		err := project.RemoveMember("fake-user-id")
+		require.NotNil(t, err)
+		require.Equal(t, "user is not a member of the project", err.Error())
+	})
+
+	t.Run("should get error when removing the owner", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		err := project.RemoveMember(owner.ID)
+		require.NotNil(t, err)
+		require.Equal(t, "[project] Owner can't be removed before transferring the project ownership", err.Error())
+		require.Len(t, project.Memberships, 1)
+	})
+
+	t.Run("should remove member", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		member, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
+		project.AddMember(member, ProjectRoleEditor)
+
+		err := project.RemoveMember(member.ID)
+		require.Nil(t, err)
+		require.Len(t, project.Memberships, 1)
+		require.False(t, project.HasMember(member))
+		require.Nil(t, project.FindMembership(member.ID))
+	})
+}
+
+func TestTransferOwnership(t *testing.T) {
+	t.Run("should get error when user is not a member of the project", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		err := project.TransferOwnership("fake-user-id")
+		require.NotNil(t, err)
+		require.Equal(t, "user is not a member of the project", err.Error())
+	})
+
+	t.Run("should get error when user already owns the project", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		err := project.TransferOwnership(owner.ID)
+		require.NotNil(t, err)
+		require.Equal(t, "[project] User already owns the project", err.Error())
+	})
+
+	t.Run("should make the member the owner and keep the previous owner as admin", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+
+		member, _ := NewUser("jondoe@test.com", "Jon Doe", "pass1234")
+		project.AddMember(member, ProjectRoleViewer)
+
+		err := project.TransferOwnership(member.ID)
+		require.Nil(t, err)
+		require.Equal(t, member.ID, project.OwnerID)
+		require.Equal(t, ProjectRoleOwner, project.FindMembership(member.ID).Role)
+		require.Equal(t, ProjectRoleAdmin, project.FindMembership(owner.ID).Role)
+		require.Nil(t, project.RemoveMember(owner.ID))
+	})
+}
+
+func TestProjectSignedIngestion(t *testing.T) {
+	t.Run("should get error when enforcing signed ingestion without signing secret", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+		err := project.ChangeSignedIngestionRequirement(true)
+		require.NotNil(t, err)
+		require.Equal(t, "[project] Signing secret is required to enforce signed ingestion", err.Error())
+		require.False(t, project.RequireSignedIngestion)
+	})
+
+	t.Run("should verify payloads signed with the current signing secret", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+		signingSecret, err := project.RotateSigningSecret()
+		require.Nil(t, err)
+		require.Equal(t, signingSecret, *project.SigningSecret)
+
+		err = project.ChangeSignedIngestionRequirement(true)
+		require.Nil(t, err)
+		require.True(t, project.RequireSignedIngestion)
+
+		body := []byte(`{"name":"signup"}`)
+		signature, err := project.SignIngestionPayload("1700000000", body)
+		require.Nil(t, err)
+		require.True(t, project.VerifyIngestionSignature("1700000000", body, signature))
+		require.False(t, project.VerifyIngestionSignature("1700000001", body, signature))
+		require.False(t, project.VerifyIngestionSignature("1700000000", []byte(`{}`), signature))
+
+		_, _ = project.RotateSigningSecret()
+		require.False(t, project.VerifyIngestionSignature("1700000000", body, signature))
+	})
+}
+
+func TestProjectChangeAllowedOrigins(t *testing.T) {
+	t.Run("should get error when an origin is invalid", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+		for _, origin := range []string{"example.com", "ftp://example.com", "https://example.com/path", "https://"} {
+			err := project.ChangeAllowedOrigins([]string{origin})
+			require.NotNil(t, err)
+			require.Equal(t, fmt.Sprintf("[project] Invalid origin %q", origin), err.Error())
+		}
+	})
+
+	t.Run("should normalize allowed origins", func(t *testing.T) {
+		owner, _ := NewUser("owner@domain.com", "Owner", "pass1234")
+		owner.Verify(*owner.VerificationToken)
+
+		project, _ := NewProject("my project", owner)
+		require.False(t, project.AllowsOrigin("https://example.com"))
+
+		err := project.ChangeAllowedOrigins([]string{"https://Example.com/", "https://example.com", "http://localhost:8080"})
 		require.Nil(t, err)
-		require.Len(t, project.Members, 2)
+		require.Equal(t, ProjectOrigins{"https://example.com", "http://localhost:8080"}, project.AllowedOrigins)
+		require.True(t, project.AllowsOrigin("https://EXAMPLE.com"))
+		require.True(t, project.AllowsOrigin("http://localhost:8080"))
+		require.False(t, project.AllowsOrigin("http://example.com"))
+		require.False(t, project.AllowsOrigin("https://evil.example.com"))
 	})
 }
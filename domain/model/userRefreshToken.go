@@ -0,0 +1,60 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+)
+
+// UserRefreshToken is single use, every refresh replaces it with a new token of the same session,
+// so the session is the token family revoked when a used token shows up again
+type UserRefreshToken struct {
+	ID        string       `json:"id" gorm:"primaryKey" valid:"uuid~[user refresh token] Invalid ID"`
+	SessionID string       `json:"session_id" gorm:"column:session_id;type:varchar(255);not null;index" valid:"required~[user refresh token] Session is required"`
+	Session   *UserSession `json:"session" valid:"-"`
+	TokenHash string       `json:"-" gorm:"column:token_hash;type:varchar(255);not null;uniqueIndex" valid:"required~[user refresh token] Token hash is required"`
+	ExpiresAt time.Time    `json:"expires_at" gorm:"not null" valid:"-"`
+	UsedAt    *time.Time   `json:"used_at" gorm:"default:null" valid:"-"`
+	CreatedAt time.Time    `json:"created_at" valid:"-"`
+}
+
+// NewUserRefreshToken expires along with the session, refreshing never extends the session
+func NewUserRefreshToken(session *UserSession, tokenHash string) (*UserRefreshToken, error) {
+	if !session.IsActive(time.Now()) {
+		return nil, errors.New("[user refresh token] Session must be active")
+	}
+
+	refreshToken := &UserRefreshToken{
+		ID:        uuid.New().String(),
+		SessionID: session.ID,
+		Session:   session,
+		TokenHash: tokenHash,
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	_, err := govalidator.ValidateStruct(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+func (refreshToken *UserRefreshToken) IsUsed() bool {
+	return refreshToken.UsedAt != nil
+}
+
+func (refreshToken *UserRefreshToken) Use(now time.Time) error {
+	if refreshToken.IsUsed() {
+		return errors.New("refresh token already used")
+	}
+
+	if !now.Before(refreshToken.ExpiresAt) {
+		return errors.New("refresh token expired")
+	}
+
+	refreshToken.UsedAt = &now
+	return nil
+}
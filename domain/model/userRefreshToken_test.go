@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserRefreshToken(t *testing.T) {
+	t.Run("should get error when session is not active", func(t *testing.T) {
+		now := time.Now()
+		session := &UserSession{ID: "fake-session-id", ExpiresAt: now.Add(time.Hour), RevokedAt: &now}
+		_, err := NewUserRefreshToken(session, "fake-token-hash")
+		require.NotNil(t, err)
+		require.Equal(t, "[user refresh token] Session must be active", err.Error())
+	})
+
+	t.Run("should create refresh token expiring along with the session", func(t *testing.T) {
+		session := &UserSession{ID: "fake-session-id", ExpiresAt: time.Now().Add(time.Hour)}
+		refreshToken, err := NewUserRefreshToken(session, "fake-token-hash")
+		require.Nil(t, err)
+		require.NotEmpty(t, refreshToken.ID)
+		require.Equal(t, session.ID, refreshToken.SessionID)
+		require.Equal(t, "fake-token-hash", refreshToken.TokenHash)
+		require.Equal(t, session.ExpiresAt, refreshToken.ExpiresAt)
+		require.False(t, refreshToken.IsUsed())
+	})
+}
+
+func TestUserRefreshToken_Use(t *testing.T) {
+	t.Run("should get error when refresh token expired", func(t *testing.T) {
+		now := time.Now()
+		refreshToken := &UserRefreshToken{ExpiresAt: now}
+		err := refreshToken.Use(now)
+		require.NotNil(t, err)
+		require.Equal(t, "refresh token expired", err.Error())
+	})
+
+	t.Run("should use refresh token only once", func(t *testing.T) {
+		now := time.Now()
+		refreshToken := &UserRefreshToken{ExpiresAt: now.Add(time.Hour)}
+		err := refreshToken.Use(now)
+		require.Nil(t, err)
+		require.True(t, refreshToken.IsUsed())
+
+		err = refreshToken.Use(now)
+		require.NotNil(t, err)
+		require.Equal(t, "refresh token already used", err.Error())
+	})
+}
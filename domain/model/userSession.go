@@ -0,0 +1,80 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	UserSessionTTL = 7 * 24 * time.Hour
+	// last seen timestamps are only refreshed once per interval to avoid a write on every request
+	UserSessionLastSeenAtResolution = time.Minute
+)
+
+// UserSession backs a signed in user, its ID is the jti claim of the access tokens issued for it
+type UserSession struct {
+	gorm.Model
+	ID         string     `json:"id" gorm:"primaryKey" valid:"uuid~[user session] Invalid ID"`
+	UserID     string     `json:"user_id" gorm:"column:user_id;type:varchar(255);not null;index" valid:"required~[user session] User is required"`
+	User       *User      `json:"user" valid:"-"`
+	UserAgent  string     `json:"user_agent" gorm:"type:varchar(512);not null;default:''" valid:"-"`
+	IpAddress  string     `json:"ip_address" gorm:"type:varchar(64);not null;default:''" valid:"-"`
+	LastSeenAt time.Time  `json:"last_seen_at" gorm:"not null" valid:"-"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null" valid:"-"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"default:null" valid:"-"`
+}
+
+func NewUserSession(user *User, userAgent string, ipAddress string) (*UserSession, error) {
+	if !user.IsVerified {
+		return nil, errors.New("[user session] User must be verified")
+	}
+
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	now := time.Now()
+	session := &UserSession{
+		ID:         uuid.New().String(),
+		UserID:     user.ID,
+		User:       user,
+		UserAgent:  userAgent,
+		IpAddress:  ipAddress,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(UserSessionTTL),
+	}
+
+	_, err := govalidator.ValidateStruct(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (session *UserSession) IsActive(now time.Time) bool {
+	return session.RevokedAt == nil && now.Before(session.ExpiresAt)
+}
+
+func (session *UserSession) Revoke(now time.Time) error {
+	if session.RevokedAt != nil {
+		return errors.New("[user session] Session already revoked")
+	}
+
+	session.RevokedAt = &now
+	return nil
+}
+
+// MarkSeen tells whether the last seen timestamp changed and should be stored
+func (session *UserSession) MarkSeen(now time.Time) bool {
+	if now.Sub(session.LastSeenAt) < UserSessionLastSeenAtResolution {
+		return false
+	}
+
+	session.LastSeenAt = now
+	return true
+}
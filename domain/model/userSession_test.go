@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserSession(t *testing.T) {
+	t.Run("should get error when user is not verified", func(t *testing.T) {
+		user, _ := NewUser("john.doe@example.com", "John Doe", "pass1234")
+		_, err := NewUserSession(user, "fake-user-agent", "127.0.0.1")
+		require.NotNil(t, err)
+		require.Equal(t, "[user session] User must be verified", err.Error())
+	})
+
+	t.Run("should create session", func(t *testing.T) {
+		user, _ := NewUser("john.doe@example.com", "John Doe", "pass1234")
+		_ = user.Verify(*user.VerificationToken)
+		session, err := NewUserSession(user, "fake-user-agent", "127.0.0.1")
+		require.Nil(t, err)
+		require.NotEmpty(t, session.ID)
+		require.Equal(t, user.ID, session.UserID)
+		require.Equal(t, "fake-user-agent", session.UserAgent)
+		require.Equal(t, "127.0.0.1", session.IpAddress)
+		require.WithinDuration(t, time.Now().Add(UserSessionTTL), session.ExpiresAt, time.Second)
+		require.True(t, session.IsActive(time.Now()))
+	})
+}
+
+func TestUserSession_Revoke(t *testing.T) {
+	t.Run("should revoke session only once", func(t *testing.T) {
+		session := &UserSession{ExpiresAt: time.Now().Add(time.Hour)}
+		now := time.Now()
+		err := session.Revoke(now)
+		require.Nil(t, err)
+		require.False(t, session.IsActive(now))
+
+		err = session.Revoke(now)
+		require.NotNil(t, err)
+		require.Equal(t, "[user session] Session already revoked", err.Error())
+	})
+}
+
+func TestUserSession_IsActive(t *testing.T) {
+	t.Run("should not be active after expiry", func(t *testing.T) {
+		now := time.Now()
+		session := &UserSession{ExpiresAt: now}
+		require.False(t, session.IsActive(now))
+		require.True(t, session.IsActive(now.Add(-time.Second)))
+	})
+}
+
+func TestUserSession_MarkSeen(t *testing.T) {
+	t.Run("should only update last seen once per resolution", func(t *testing.T) {
+		now := time.Now()
+		session := &UserSession{LastSeenAt: now}
+		require.False(t, session.MarkSeen(now.Add(UserSessionLastSeenAtResolution/2)))
+		require.Equal(t, now, session.LastSeenAt)
+
+		later := now.Add(UserSessionLastSeenAtResolution)
+		require.True(t, session.MarkSeen(later))
+		require.Equal(t, later, session.LastSeenAt)
+	})
+}